@@ -0,0 +1,86 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// AlertTypeUsageLimit alerts are sent when Percentage of the email
+	// credits of the plan is used.
+	AlertTypeUsageLimit = "usage_limit"
+	// AlertTypeStatsNotification alerts send the email statistics at
+	// Frequency, daily, weekly or monthly.
+	AlertTypeStatsNotification = "stats_notification"
+)
+
+// Alert notifies EmailTo about the usage or the statistics of the account.
+// Only the field matching Type is set, Percentage or Frequency.
+type Alert struct {
+	ID         int64  `json:"id,omitempty"`
+	Type       string `json:"type,omitempty"`
+	EmailTo    string `json:"email_to"`
+	Frequency  string `json:"frequency,omitempty"`
+	Percentage int64  `json:"percentage,omitempty"`
+	CreatedAt  int64  `json:"created_at,omitempty"`
+	UpdatedAt  int64  `json:"updated_at,omitempty"`
+}
+
+func parseAlert(respBody string) (*Alert, error) {
+	var body Alert
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing alert: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (c *Client) CreateAlert(ctx context.Context, alert Alert) (*Alert, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/alerts", Alert{
+		Type:       alert.Type,
+		EmailTo:    alert.EmailTo,
+		Frequency:  alert.Frequency,
+		Percentage: alert.Percentage,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CreateAlert: %w", err)
+	}
+
+	return parseAlert(respBody)
+}
+
+func (c *Client) ReadAlert(ctx context.Context, alertID int64) (*Alert, error) {
+	respBody, _, err := c.Get(ctx, "GET", fmt.Sprintf("/alerts/%d", alertID))
+	if err != nil {
+		return nil, fmt.Errorf("ReadAlert: %w", err)
+	}
+
+	return parseAlert(respBody)
+}
+
+// UpdateAlert updates the recipient and the percentage or frequency of an
+// alert, the type cannot be changed.
+func (c *Client) UpdateAlert(ctx context.Context, alert Alert) (*Alert, error) {
+	respBody, _, err := c.Post(ctx, "PATCH", fmt.Sprintf("/alerts/%d", alert.ID), Alert{
+		EmailTo:    alert.EmailTo,
+		Frequency:  alert.Frequency,
+		Percentage: alert.Percentage,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UpdateAlert: %w", err)
+	}
+
+	return parseAlert(respBody)
+}
+
+func (c *Client) DeleteAlert(ctx context.Context, alertID int64) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", fmt.Sprintf("/alerts/%d", alertID))
+	if err != nil {
+		return false, fmt.Errorf("DeleteAlert: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,50 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAlertLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	if _, err := c.CreateAlert(ctx, Alert{Type: AlertTypeUsageLimit, EmailTo: "oncall@example.com", Frequency: "daily"}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for a usage limit alert without percentage, got %v", err)
+	}
+
+	usage, err := c.CreateAlert(ctx, Alert{Type: AlertTypeUsageLimit, EmailTo: "oncall@example.com", Percentage: 90})
+	if err != nil {
+		t.Fatalf("CreateAlert: %s", err)
+	}
+	if usage.ID == 0 || usage.Percentage != 90 || usage.Frequency != "" {
+		t.Errorf("unexpected alert: %+v", usage)
+	}
+
+	stats, err := c.CreateAlert(ctx, Alert{Type: AlertTypeStatsNotification, EmailTo: "stats@example.com", Frequency: "weekly"})
+	if err != nil {
+		t.Fatalf("CreateAlert: %s", err)
+	}
+
+	if _, err := c.UpdateAlert(ctx, Alert{ID: usage.ID, EmailTo: "ops@example.com", Percentage: 75}); err != nil {
+		t.Fatalf("UpdateAlert: %s", err)
+	}
+
+	usage, err = c.ReadAlert(ctx, usage.ID)
+	if err != nil {
+		t.Fatalf("ReadAlert: %s", err)
+	}
+	if usage.Type != AlertTypeUsageLimit || usage.EmailTo != "ops@example.com" || usage.Percentage != 75 {
+		t.Errorf("unexpected alert: %+v", usage)
+	}
+
+	if _, err := c.DeleteAlert(ctx, usage.ID); err != nil {
+		t.Fatalf("DeleteAlert: %s", err)
+	}
+	if _, err := c.ReadAlert(ctx, usage.ID); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+	if _, err := c.ReadAlert(ctx, stats.ID); err != nil {
+		t.Errorf("ReadAlert: %s", err)
+	}
+}
@@ -25,7 +25,7 @@ func (c *Client) CreateApiKey(ctx context.Context, receiveditems ChildApiKey) (*
 		Scopes: receiveditems.Scopes,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("CreateApiKey: Bad Request:" + err.Error())
+		return nil, fmt.Errorf("CreateApiKey: Bad Request: %w", err)
 	}
 
 	var response ChildApiKey
@@ -41,7 +41,7 @@ func (c *Client) CreateApiKey(ctx context.Context, receiveditems ChildApiKey) (*
 func (c *Client) ReadApiKey(ctx context.Context, apikeyid string) (*ChildApiKey, error) {
 	respBody, _, err := c.Get(ctx, "GET", "/api_keys/"+apikeyid)
 	if err != nil {
-		return nil, fmt.Errorf("ReadApiKey: Bad Request:" + err.Error())
+		return nil, fmt.Errorf("ReadApiKey: Bad Request: %w", err)
 	}
 
 	var response ChildApiKey
@@ -56,7 +56,7 @@ func (c *Client) ReadApiKey(ctx context.Context, apikeyid string) (*ChildApiKey,
 func (c *Client) DeleteApiKey(ctx context.Context, apikeyid string) (bool, error) {
 	delrespbody, statuscode, err := c.Get(ctx, "DELETE", "/api_keys/"+apikeyid)
 	if err != nil {
-		return false, fmt.Errorf("DeleteApiKey: Bad Request:" + err.Error())
+		return false, fmt.Errorf("DeleteApiKey: Bad Request: %w", err)
 	}
 
 	if delrespbody == "" && statuscode == 204 {
@@ -73,7 +73,7 @@ func (c *Client) UpdateApiKey(ctx context.Context, receivedupdateitems ChildApiK
 		Scopes: receivedupdateitems.Scopes,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("UpdateApiKey: Bad Request:" + err.Error())
+		return nil, fmt.Errorf("UpdateApiKey: Bad Request: %w", err)
 	}
 
 	var updateresponse ChildApiKey
@@ -91,7 +91,7 @@ func (c *Client) UpdateApiKeyName(ctx context.Context, nametoupdate ChildApiKey)
 		Name: nametoupdate.Name,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("UpdateApiKeyName: Bad Request:" + err.Error())
+		return nil, fmt.Errorf("UpdateApiKeyName: Bad Request: %w", err)
 	}
 
 	var updateresponse ChildApiKey
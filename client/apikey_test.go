@@ -0,0 +1,48 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestApiKeyLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	created, err := c.CreateApiKey(ctx, ChildApiKey{Name: "test", Scopes: []string{"mail.send"}})
+	if err != nil {
+		t.Fatalf("CreateApiKey: %s", err)
+	}
+	if created.ID == "" || created.Apikey == "" {
+		t.Fatalf("expected id and key to be set: %+v", created)
+	}
+
+	updated, err := c.UpdateApiKey(ctx, ChildApiKey{ID: created.ID, Name: "renamed", Scopes: []string{"mail.send", "alerts.read"}})
+	if err != nil {
+		t.Fatalf("UpdateApiKey: %s", err)
+	}
+	if updated.Name != "renamed" || len(updated.Scopes) != 2 {
+		t.Errorf("unexpected update response: %+v", updated)
+	}
+
+	if _, err := c.UpdateApiKeyName(ctx, ChildApiKey{ID: created.ID, Name: "again"}); err != nil {
+		t.Fatalf("UpdateApiKeyName: %s", err)
+	}
+
+	read, err := c.ReadApiKey(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("ReadApiKey: %s", err)
+	}
+	if read.Name != "again" || len(read.Scopes) != 2 {
+		t.Errorf("unexpected api key: %+v", read)
+	}
+
+	deleted, err := c.DeleteApiKey(ctx, created.ID)
+	if err != nil || !deleted {
+		t.Fatalf("DeleteApiKey: %t, %v", deleted, err)
+	}
+
+	if _, err := c.ReadApiKey(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
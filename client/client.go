@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/sendgrid/rest"
@@ -13,22 +14,137 @@ import (
 
 const HostURL string = "https://api.sendgrid.com/v3"
 
+// EUHostURL is the API base URL for accounts pinned to EU data residency.
+const EUHostURL string = "https://api.eu.sendgrid.com/v3"
+
+const (
+	RegionGlobal = "global"
+	RegionEU     = "eu"
+)
+
+const defaultTimeout = 10 * time.Second
+
 type Client struct {
-	ApiKey     string
-	HTTPClient *http.Client
+	ApiKey       string
+	BaseURL      string
+	Subuser      string
+	HTTPClient   *http.Client
+	MaxRetries   int
+	RetryMaxWait time.Duration
+	PageSize     int
+}
+
+// Option configures optional settings of a Client created by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient makes the client send every request through httpClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.HTTPClient = httpClient
+		}
+	}
+}
+
+// WithTransport sets the RoundTripper used by the client's http.Client,
+// e.g. to configure a proxy, a custom CA bundle or a test transport. The
+// http.Client is copied first so one passed to WithHTTPClient is not modified.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		hc := *c.HTTPClient
+		hc.Transport = transport
+		c.HTTPClient = &hc
+	}
+}
+
+// WithBaseURL overrides the API base URL, e.g. to target a local stand-in
+// server. The URL must include the version path, like HostURL does.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if baseURL != "" {
+			c.BaseURL = strings.TrimRight(baseURL, "/")
+		}
+	}
 }
 
-func NewClient(apiKey string) (*Client, error) {
+// WithRegion selects the API base URL for the given data residency region.
+func WithRegion(region string) Option {
+	return func(c *Client) {
+		if url, ok := RegionHostURL(region); ok {
+			c.BaseURL = url
+		}
+	}
+}
+
+// RegionHostURL returns the API base URL of region.
+func RegionHostURL(region string) (string, bool) {
+	switch region {
+	case RegionGlobal, "":
+		return HostURL, true
+	case RegionEU:
+		return EUHostURL, true
+	}
+	return "", false
+}
+
+// WithSubuser makes every request act on behalf of the given subuser.
+func WithSubuser(subuser string) Option {
+	return func(c *Client) {
+		c.Subuser = subuser
+	}
+}
+
+// WithTimeout sets the timeout of a copy of the client's http.Client.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		hc := *c.HTTPClient
+		hc.Timeout = timeout
+		c.HTTPClient = &hc
+	}
+}
+
+func NewClient(apiKey string, opts ...Option) (*Client, error) {
 	if apiKey == "" {
 		return nil, fmt.Errorf("clientgo: apikey is required")
 	}
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
-		ApiKey:     apiKey,
+		HTTPClient:   &http.Client{Timeout: defaultTimeout},
+		BaseURL:      HostURL,
+		ApiKey:       apiKey,
+		MaxRetries:   DefaultMaxRetries,
+		RetryMaxWait: DefaultRetryMaxWait,
+		PageSize:     DefaultPageSize,
+	}
+	for _, opt := range opts {
+		opt(&c)
 	}
 	return &c, nil
 }
 
+// OnBehalfOf returns a copy of the client that impersonates subuser through
+// the on-behalf-of header. The copy shares the http.Client of c. An empty
+// subuser returns c unchanged.
+func (c *Client) OnBehalfOf(subuser string) *Client {
+	if subuser == "" || subuser == c.Subuser {
+		return c
+	}
+	scoped := *c
+	scoped.Subuser = subuser
+	return &scoped
+}
+
+// Parent returns a copy of the client that acts as the parent account,
+// without the on-behalf-of header of a provider level subuser. Subusers,
+// teammates and IPs can only be managed by the parent account.
+func (c *Client) Parent() *Client {
+	if c.Subuser == "" {
+		return c
+	}
+	parent := *c
+	parent.Subuser = ""
+	return &parent
+}
+
 func bodyToJSON(body interface{}) ([]byte, error) {
 	if body == nil {
 		return nil, fmt.Errorf("clientgo: body could not be jsonified")
@@ -41,26 +157,63 @@ func bodyToJSON(body interface{}) ([]byte, error) {
 	return jsonBody, nil
 }
 
+// send executes req through the client's own http.Client instead of the
+// package level default client of sendgrid-go, retrying transient failures.
+func (c *Client) send(ctx context.Context, req rest.Request, idempotent bool) (*rest.Response, error) {
+	restClient := &rest.Client{HTTPClient: c.HTTPClient}
+	return c.sendWithRetry(ctx, restClient, req, idempotent)
+}
+
 func (c *Client) Get(ctx context.Context, method rest.Method, endpoint string) (string, int, error) {
 
+	resp, err := c.getResponse(ctx, method, endpoint)
+	if err != nil {
+		if resp != nil {
+			return "", resp.StatusCode, err
+		}
+		return "", 0, err
+	}
+
+	return resp.Body, resp.StatusCode, nil
+
+}
+
+// getResponse is Get returning the whole response, headers included. The
+// response is also returned along with an APIError.
+func (c *Client) getResponse(ctx context.Context, method rest.Method, endpoint string) (*rest.Response, error) {
+
 	var req rest.Request
-	req = sendgrid.GetRequest(c.ApiKey, endpoint, HostURL)
+	req = sendgrid.GetRequestSubuser(c.ApiKey, endpoint, c.BaseURL, c.Subuser)
 	req.Method = method
 
-	resp, err := sendgrid.API(req)
-	if err != nil || resp.StatusCode >= 400 {
-		return "", resp.StatusCode, fmt.Errorf("clientgetfunc:api response: http %d: %s, err: %v", resp.StatusCode, resp.Body, err)
+	resp, err := c.send(ctx, req, isIdempotent(method))
+	if err != nil {
+		return nil, fmt.Errorf("clientgetfunc: request failed: %w", err)
 	}
 
-	return resp.Body, resp.StatusCode, nil
+	if resp.StatusCode >= 400 {
+		return resp, newAPIError(method, endpoint, resp)
+	}
 
+	return resp, nil
 }
 
 func (c *Client) Post(ctx context.Context, method rest.Method, endpoint string, body interface{}) (string, int, error) {
+	return c.post(ctx, method, endpoint, body, isIdempotent(method))
+}
+
+// search sends a POST that only reads, such as a search taking its criteria
+// in the request body. Unlike other POSTs it is retried on server and
+// network errors.
+func (c *Client) search(ctx context.Context, endpoint string, body interface{}) (string, int, error) {
+	return c.post(ctx, "POST", endpoint, body, true)
+}
+
+func (c *Client) post(ctx context.Context, method rest.Method, endpoint string, body interface{}, idempotent bool) (string, int, error) {
 	var err error
 
 	var req rest.Request
-	req = sendgrid.GetRequest(c.ApiKey, endpoint, HostURL)
+	req = sendgrid.GetRequestSubuser(c.ApiKey, endpoint, c.BaseURL, c.Subuser)
 	req.Method = method
 
 	if body != nil {
@@ -71,14 +224,13 @@ func (c *Client) Post(ctx context.Context, method rest.Method, endpoint string,
 		return "", 0, fmt.Errorf("ClientGo: Failed preparing request body: %w", err)
 	}
 
-	resp, err := sendgrid.API(req)
-
-	if err != nil || resp.StatusCode >= 400 {
-		return "", resp.StatusCode, fmt.Errorf("api response: http %d: %s, err: %v", resp.StatusCode, resp.Body)
+	resp, err := c.send(ctx, req, idempotent)
+	if err != nil {
+		return "", 0, fmt.Errorf("clientgo: api post func error: %w", err)
 	}
 
-	if err != nil {
-		return "", 0, fmt.Errorf("clientgo: api post func error: %v", err)
+	if resp.StatusCode >= 400 {
+		return "", resp.StatusCode, newAPIError(method, endpoint, resp)
 	}
 
 	return resp.Body, resp.StatusCode, nil
@@ -0,0 +1,210 @@
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newFakeClient returns a client talking to a fresh in-memory SendGrid fake.
+func newFakeClient(t *testing.T) (*Client, *sendgridtest.Server) {
+	t.Helper()
+
+	srv := sendgridtest.NewServer()
+	t.Cleanup(srv.Close)
+
+	c, err := NewClient(sendgridtest.APIKey, WithBaseURL(srv.BaseURL()), WithRetry(0, time.Second))
+	if err != nil {
+		t.Fatalf("NewClient: %s", err)
+	}
+
+	return c, srv
+}
+
+func TestClientUsesConfiguredTransport(t *testing.T) {
+	var gotURL, gotAuth string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotURL = req.URL.String()
+		gotAuth = req.Header.Get("Authorization")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"api_key_id":"abc","name":"test"}`)),
+		}, nil
+	})
+
+	c, err := NewClient("SG.test", WithTransport(transport), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("NewClient: %s", err)
+	}
+	if c.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("expected timeout 5s, got %s", c.HTTPClient.Timeout)
+	}
+
+	key, err := c.ReadApiKey(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("ReadApiKey: %s", err)
+	}
+	if key.ID != "abc" || key.Name != "test" {
+		t.Errorf("unexpected api key: %+v", key)
+	}
+	if gotURL != HostURL+"/api_keys/abc" {
+		t.Errorf("unexpected url: %s", gotURL)
+	}
+	if gotAuth != "Bearer SG.test" {
+		t.Errorf("unexpected authorization header: %s", gotAuth)
+	}
+}
+
+func TestClientOptionsDoNotModifyHTTPClient(t *testing.T) {
+	httpClient := &http.Client{}
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("unused")
+	})
+
+	c, err := NewClient("SG.test", WithHTTPClient(httpClient), WithTransport(transport), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("NewClient: %s", err)
+	}
+	if httpClient.Transport != nil || httpClient.Timeout != 0 {
+		t.Errorf("expected the provided http.Client to be left unchanged, got %+v", httpClient)
+	}
+	if c.HTTPClient.Transport == nil || c.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("expected the client to use the configured transport and timeout, got %+v", c.HTTPClient)
+	}
+}
+
+func TestClientWithHTTPClient(t *testing.T) {
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"errors":[{"message":"bad"}]}`)),
+		}, nil
+	})}
+
+	c, err := NewClient("SG.test", WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient: %s", err)
+	}
+	if c.HTTPClient != httpClient {
+		t.Fatalf("expected the provided http.Client to be used")
+	}
+
+	_, status, err := c.Post(context.Background(), "POST", "/api_keys", CreateApikey{Name: "test"})
+	if err == nil {
+		t.Fatalf("expected an error for a 400 response")
+	}
+	if status != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", status)
+	}
+}
+
+func TestClientBaseURL(t *testing.T) {
+	var gotURL string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotURL = req.URL.String()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	})
+
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{name: "default", want: HostURL + "/subusers/test"},
+		{name: "eu region", opts: []Option{WithRegion(RegionEU)}, want: EUHostURL + "/subusers/test"},
+		{name: "base url wins", opts: []Option{WithRegion(RegionEU), WithBaseURL("http://127.0.0.1:8080/v3/")}, want: "http://127.0.0.1:8080/v3/subusers/test"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient("SG.test", append(tt.opts, WithTransport(transport))...)
+			if err != nil {
+				t.Fatalf("NewClient: %s", err)
+			}
+			if _, err := c.ReadSubuser(context.Background(), "test"); err != nil {
+				t.Fatalf("ReadSubuser: %s", err)
+			}
+			if gotURL != tt.want {
+				t.Errorf("expected url %s, got %s", tt.want, gotURL)
+			}
+		})
+	}
+}
+
+func TestClientOnBehalfOf(t *testing.T) {
+	var gotSubuser string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotSubuser = req.Header.Get("On-Behalf-Of")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	})
+
+	c, _ := NewClient("SG.test", WithTransport(transport), WithSubuser("parent-default"))
+
+	if _, err := c.ReadApiKey(context.Background(), "abc"); err != nil {
+		t.Fatalf("ReadApiKey: %s", err)
+	}
+	if gotSubuser != "parent-default" {
+		t.Errorf("expected provider level subuser, got %q", gotSubuser)
+	}
+
+	scoped := c.OnBehalfOf("subuser1")
+	if _, err := scoped.ReadApiKey(context.Background(), "abc"); err != nil {
+		t.Fatalf("ReadApiKey: %s", err)
+	}
+	if gotSubuser != "subuser1" {
+		t.Errorf("expected override subuser, got %q", gotSubuser)
+	}
+	if c.Subuser != "parent-default" {
+		t.Errorf("OnBehalfOf must not modify the original client")
+	}
+	if scoped.HTTPClient != c.HTTPClient {
+		t.Errorf("expected the scoped client to share the http.Client")
+	}
+}
+
+func TestClientParent(t *testing.T) {
+	var gotSubuser []string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotSubuser = req.Header.Values("On-Behalf-Of")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	})
+
+	c, _ := NewClient("SG.test", WithTransport(transport), WithSubuser("parent-default"))
+
+	parent := c.Parent()
+	if _, err := parent.ReadApiKey(context.Background(), "abc"); err != nil {
+		t.Fatalf("ReadApiKey: %s", err)
+	}
+	if len(gotSubuser) != 0 {
+		t.Errorf("expected no on-behalf-of header, got %q", gotSubuser)
+	}
+	if c.Subuser != "parent-default" {
+		t.Errorf("Parent must not modify the original client")
+	}
+}
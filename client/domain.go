@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
@@ -45,37 +46,84 @@ type DomainAuth struct {
 	Subusers      []DomainAuthSubuser `json:"subusers,omitempty"`
 }
 
+// domains pages through /whitelabel/domains, narrowed down by the filter
+// query parameters.
+func (c *Client) domains(filter url.Values) *paginator[DomainAuth] {
+	return newPaginator(c, withQuery("/whitelabel/domains", filter), func(respBody string) ([]DomainAuth, error) {
+		var domains []DomainAuth
+		err := json.Unmarshal([]byte(respBody), &domains)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing domains: %w", err)
+		}
+		return domains, nil
+	})
+}
+
+// GetDomainAuth reads the authenticated domain with the ID of domainid. When
+// no ID is given the domain is looked up by its name instead, see
+// FindDomainAuth. A missing domain is reported with an error wrapping
+// ErrNotFound.
 func (c *Client) GetDomainAuth(ctx context.Context, domainid DomainAuth) (*DomainAuth, error) {
+	if domainid.ID == 0 {
+		return c.FindDomainAuth(ctx, domainid)
+	}
 
-	//return nil, fmt.Errorf("domainauth not found:%+v", domainid)
+	respBody, _, err := c.Get(ctx, "GET", "/whitelabel/domains/"+fmt.Sprintf("%d", domainid.ID))
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, fmt.Errorf("domainauth %d: %w", domainid.ID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("domainauth %d: %w", domainid.ID, err)
+	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/whitelabel/domains")
+	var domain DomainAuth
+	err = json.Unmarshal([]byte(respBody), &domain)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("domainauth %d: failed parsing domain: %w", domainid.ID, err)
+	}
+
+	if len(domain.Subusers) == 0 {
+		domain.Subusers = []DomainAuthSubuser{
+			{
+				Username: "",
+				UserID:   0,
+			},
+		}
+	}
+
+	return &domain, nil
+}
+
+// FindDomainAuth looks up an authenticated domain by the Domain of
+// domainid, narrowed down by Subdomain and Username when they are set.
+func (c *Client) FindDomainAuth(ctx context.Context, domainid DomainAuth) (*DomainAuth, error) {
+	if domainid.Domain == "" {
+		return nil, fmt.Errorf("finddomainauth: either the id or the domain is required")
+	}
+
+	filter := url.Values{"domain": {domainid.Domain}}
+	if domainid.Username != "" {
+		filter.Set("username", domainid.Username)
 	}
 
-	var domainauth []DomainAuth
-	err = json.Unmarshal([]byte(respBody), &domainauth)
+	domain, found, err := c.domains(filter).find(ctx, func(domain DomainAuth) bool {
+		return domain.Domain == domainid.Domain &&
+			(domainid.Subdomain == "" || domain.Subdomain == domainid.Subdomain) &&
+			(domainid.Username == "" || domain.Username == domainid.Username)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	//return nil, fmt.Errorf("domainauth not found:%+v", domainauth)
-	for _, domain := range domainauth {
-		if domain.ID == domainid.ID {
-			if len(domain.Subusers) == 0 {
-				domain.Subusers = []DomainAuthSubuser{
-					{
-						Username: "",
-						UserID:   0,
-					},
-				}
-			}
-			return &domain, nil
+	if !found {
+		name := domainid.Domain
+		if domainid.Subdomain != "" {
+			name = domainid.Subdomain + "." + name
 		}
+		return nil, fmt.Errorf("domainauth %s: %w", name, ErrNotFound)
 	}
 
-	return nil, fmt.Errorf("domainauth not found:%+v", domainid.ID)
+	return c.GetDomainAuth(ctx, DomainAuth{ID: domain.ID})
 }
 
 func (c *Client) CreateDomainAuth(ctx context.Context, domainauth DomainAuth) (*DomainAuth, error) {
@@ -97,7 +145,7 @@ func (c *Client) CreateDomainAuth(ctx context.Context, domainauth DomainAuth) (*
 	var domainauthresp DomainAuth
 	err = json.Unmarshal([]byte(createrespBody), &domainauthresp)
 	if err != nil {
-		return nil, fmt.Errorf("createdomainauth: domain creation failed:%s", err.Error())
+		return nil, fmt.Errorf("createdomainauth: domain creation failed: %w", err)
 	}
 
 	return c.GetDomainAuth(ctx, domainauthresp)
@@ -108,13 +156,13 @@ func (c *Client) ValidateDomainAuth(ctx context.Context, domainauth DomainAuth)
 
 	validdomain, statuscode, err := c.Post(ctx, "POST", "/whitelabel/domains/"+fmt.Sprintf("%d", domainauth.ID)+"/validate", nil)
 	if err != nil && statuscode != http.StatusOK {
-		return nil, fmt.Errorf("domain validation failed:%s", err.Error())
+		return nil, fmt.Errorf("domain validation failed: %w", err)
 	}
 
 	var validatedomainresp DomainAuth
 	err = json.Unmarshal([]byte(validdomain), &validatedomainresp)
 	if err != nil {
-		return nil, fmt.Errorf("domain validation failed:%s", err.Error())
+		return nil, fmt.Errorf("domain validation failed: %w", err)
 	}
 	return &validatedomainresp, nil
 }
@@ -126,13 +174,13 @@ func (c *Client) UpdateDomainAuth(ctx context.Context, updatedetails DomainAuth)
 		CustomSPF:     updatedetails.CustomSPF,
 	})
 	if err != nil && statuscode != http.StatusOK {
-		return nil, fmt.Errorf("updatedomainauth: domain update failed:%s", err.Error())
+		return nil, fmt.Errorf("updatedomainauth: domain update failed: %w", err)
 	}
 
 	var updatedomainresp DomainAuth
 	err = json.Unmarshal([]byte(updatedomaindets), &updatedomainresp)
 	if err != nil {
-		return nil, fmt.Errorf("updatedomainauth: domain update failed:%s", err.Error())
+		return nil, fmt.Errorf("updatedomainauth: domain update failed: %w", err)
 	}
 	return &updatedomainresp, nil
 }
@@ -166,12 +214,12 @@ func (c *Client) CreateDomainAuthSubuser(ctx context.Context, domainauth DomainA
 	var domainauthresp DomainAuth
 	err = json.Unmarshal([]byte(createrespBody), &domainauthresp)
 	if err != nil {
-		return nil, fmt.Errorf("associatesubuser: subuser association failed:%s", err.Error())
+		return nil, fmt.Errorf("associatesubuser: subuser association failed: %w", err)
 	}
 
 	getuserdetails, _ := c.GetSubuser(ctx, Subuser{Username: domainauth.Username})
 	if err != nil {
-		return nil, fmt.Errorf("associatesubuser: Failed to retrieve subuser details:%s", err.Error())
+		return nil, fmt.Errorf("associatesubuser: Failed to retrieve subuser details: %w", err)
 	}
 
 	domainauthresp.Subusers = []DomainAuthSubuser{
@@ -191,53 +239,50 @@ func (c *Client) GetDomainSubuser(ctx context.Context, domainid DomainAuth) (*Do
 
 	//return nil, fmt.Errorf("domainauth not found:%+v", domainid)
 
-	respBody, _, err := c.Get(ctx, "GET", "/whitelabel/domains")
+	respBody, _, err := c.Get(ctx, "GET", "/whitelabel/domains/"+fmt.Sprintf("%d", domainid.ID))
 	if err != nil {
-		return nil, err
+		if IsNotFound(err) {
+			return nil, fmt.Errorf("domainauthsubuser:domainauth %d: %w", domainid.ID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("getdomainsubuser: %w", err)
 	}
 
-	var domainauth []DomainAuth
-	err = json.Unmarshal([]byte(respBody), &domainauth)
+	var domain DomainAuth
+	err = json.Unmarshal([]byte(respBody), &domain)
 	if err != nil {
-		return nil, fmt.Errorf("getdomainsubuser: domain subuser parsing failed:%s", err.Error())
-	}
-
-	// return nil, fmt.Errorf("domainauth not found:%+v", domainauth)
-	for _, domain := range domainauth {
-		if domain.ID == domainid.ID {
-			if len(domain.Subusers) > 0 {
-				for _, userinlist := range domain.Subusers {
-					if userinlist.Username == domainid.Username {
-						domain.Subusers = []DomainAuthSubuser{
-							{
-								Username: userinlist.Username,
-								UserID:   userinlist.UserID,
-							},
-						}
-						//return nil, fmt.Errorf("domainauthsubuser:domainauth already exists:%+v", domainid)
-						return &domain, nil
-					}
-				}
-			} else {
-				domain.Subusers = []DomainAuthSubuser{
-					{
-						Username: domainid.Username,
-						UserID:   domain.UserId,
-					},
-				}
-				return &domain, nil
+		return nil, fmt.Errorf("getdomainsubuser: domain subuser parsing failed: %w", err)
+	}
+
+	if len(domain.Subusers) == 0 {
+		domain.Subusers = []DomainAuthSubuser{
+			{
+				Username: domainid.Username,
+				UserID:   domain.UserId,
+			},
+		}
+		return &domain, nil
+	}
+
+	for _, userinlist := range domain.Subusers {
+		if userinlist.Username == domainid.Username {
+			domain.Subusers = []DomainAuthSubuser{
+				{
+					Username: userinlist.Username,
+					UserID:   userinlist.UserID,
+				},
 			}
+			return &domain, nil
 		}
 	}
 
-	return nil, fmt.Errorf("domainauthsubuser:domainauth not found:%+v", domainid.ID)
+	return nil, fmt.Errorf("domainauthsubuser:domainauth %d subuser %s: %w", domainid.ID, domainid.Username, ErrNotFound)
 }
 
 func (c *Client) DeleteDomainAuthSubuser(ctx context.Context, domainauth DomainAuth) (bool, error) {
 
 	_, _, err := c.Get(ctx, "DELETE", "/whitelabel/domains/subuser?username="+domainauth.Username)
 	if err != nil {
-		return false, fmt.Errorf("removesubuserfromdomain: subuser disassociation failed:%s", err.Error())
+		return false, fmt.Errorf("removesubuserfromdomain: subuser disassociation failed: %w", err)
 	}
 
 	//return c.GetDomainAuth(ctx, domainauthresp)
@@ -0,0 +1,120 @@
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestDomainAuthLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	created, err := c.CreateDomainAuth(ctx, DomainAuth{Domain: "example.com", Subdomain: "em"})
+	if err != nil {
+		t.Fatalf("CreateDomainAuth: %s", err)
+	}
+	if created.ID == 0 || created.DNSDetails.MailCNAME.Host != "em.example.com" || created.Valid {
+		t.Errorf("unexpected domain: %+v", created)
+	}
+
+	updated, err := c.UpdateDomainAuth(ctx, DomainAuth{ID: created.ID, Defaultdomain: true})
+	if err != nil {
+		t.Fatalf("UpdateDomainAuth: %s", err)
+	}
+	if !updated.Defaultdomain {
+		t.Errorf("expected default domain: %+v", updated)
+	}
+
+	validated, err := c.ValidateDomainAuth(ctx, DomainAuth{ID: created.ID})
+	if err != nil {
+		t.Fatalf("ValidateDomainAuth: %s", err)
+	}
+	if !validated.Valid {
+		t.Errorf("expected valid domain: %+v", validated)
+	}
+
+	if _, err := c.CreateSubuser(ctx, Subuser{Username: "sub1", Email: "sub1@example.com", Password: "secret"}); err != nil {
+		t.Fatalf("CreateSubuser: %s", err)
+	}
+	associated, err := c.CreateDomainAuthSubuser(ctx, DomainAuth{ID: created.ID, Username: "sub1"})
+	if err != nil {
+		t.Fatalf("CreateDomainAuthSubuser: %s", err)
+	}
+	if associated.Username != "sub1" {
+		t.Errorf("unexpected association: %+v", associated)
+	}
+
+	withSubuser, err := c.GetDomainSubuser(ctx, DomainAuth{ID: created.ID, Username: "sub1"})
+	if err != nil {
+		t.Fatalf("GetDomainSubuser: %s", err)
+	}
+	if len(withSubuser.Subusers) != 1 || withSubuser.Subusers[0].Username != "sub1" {
+		t.Errorf("unexpected subusers: %+v", withSubuser.Subusers)
+	}
+
+	if _, err := c.DeleteDomainAuthSubuser(ctx, DomainAuth{Username: "sub1"}); err != nil {
+		t.Fatalf("DeleteDomainAuthSubuser: %s", err)
+	}
+
+	if _, err := c.DeleteDomainAuth(ctx, fmt.Sprint(created.ID)); err != nil {
+		t.Fatalf("DeleteDomainAuth: %s", err)
+	}
+	_, err = c.GetDomainAuth(ctx, DomainAuth{ID: created.ID})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestGetDomainAuthByName(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	for _, sub := range []string{"em", "mail"} {
+		if _, err := c.CreateDomainAuth(ctx, DomainAuth{Domain: "example.com", Subdomain: sub}); err != nil {
+			t.Fatalf("CreateDomainAuth: %s", err)
+		}
+	}
+
+	found, err := c.GetDomainAuth(ctx, DomainAuth{Domain: "example.com", Subdomain: "mail"})
+	if err != nil {
+		t.Fatalf("GetDomainAuth: %s", err)
+	}
+	if found.Subdomain != "mail" || found.DNSDetails.MailCNAME.Host != "mail.example.com" {
+		t.Errorf("unexpected domain: %+v", found)
+	}
+
+	_, err = c.GetDomainAuth(ctx, DomainAuth{Domain: "example.org"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	if _, err := c.GetDomainAuth(ctx, DomainAuth{}); err == nil || IsNotFound(err) {
+		t.Errorf("expected an error without id or domain, got %v", err)
+	}
+}
+
+func TestFindDomainAuthFiltersByDomain(t *testing.T) {
+	var query url.Values
+	c, _ := NewClient("SG.test", WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`[]`)),
+		}, nil
+	})))
+
+	_, err := c.FindDomainAuth(context.Background(), DomainAuth{Domain: "example.com", Subdomain: "em", Username: "sub1"})
+	if !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "em.example.com") {
+		t.Errorf("expected not found for em.example.com, got %v", err)
+	}
+	if query.Get("domain") != "example.com" || query.Get("username") != "sub1" {
+		t.Errorf("unexpected query: %v", query)
+	}
+}
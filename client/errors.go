@@ -0,0 +1,109 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sendgrid/rest"
+)
+
+// ErrNotFound is returned when an object looked up in a list response does
+// not exist. Single object endpoints report the same through a 404 APIError.
+var ErrNotFound = errors.New("not found")
+
+// APIErrorItem is a single entry of the errors array returned by SendGrid.
+type APIErrorItem struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// APIError is returned by the client when SendGrid answers with a status
+// code of 400 or above.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Endpoint   string
+	Errors     []APIErrorItem
+	RequestID  string
+	Body       string
+}
+
+type apiErrorBody struct {
+	Errors []APIErrorItem `json:"errors"`
+	Error  string         `json:"error"`
+}
+
+func newAPIError(method rest.Method, endpoint string, resp *rest.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     string(method),
+		Endpoint:   endpoint,
+		RequestID:  http.Header(resp.Headers).Get("X-Request-Id"),
+		Body:       resp.Body,
+	}
+
+	var body apiErrorBody
+	if err := json.Unmarshal([]byte(resp.Body), &body); err == nil {
+		apiErr.Errors = body.Errors
+		if len(apiErr.Errors) == 0 && body.Error != "" {
+			apiErr.Errors = []APIErrorItem{{Message: body.Error}}
+		}
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "api response: %s %s: http %d", e.Method, e.Endpoint, e.StatusCode)
+
+	if len(e.Errors) > 0 {
+		messages := make([]string, 0, len(e.Errors))
+		for _, item := range e.Errors {
+			if item.Field != "" {
+				messages = append(messages, item.Field+": "+item.Message)
+			} else {
+				messages = append(messages, item.Message)
+			}
+		}
+		fmt.Fprintf(&b, ": %s", strings.Join(messages, "; "))
+	} else if e.Body != "" {
+		fmt.Fprintf(&b, ": %s", e.Body)
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request id: %s)", e.RequestID)
+	}
+
+	return b.String()
+}
+
+// StatusCodeOf returns the http status code of an *APIError in err's chain,
+// or 0 when err does not originate from a SendGrid response.
+func StatusCodeOf(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is a SendGrid 404 response or wraps
+// ErrNotFound.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || StatusCodeOf(err) == http.StatusNotFound
+}
+
+// IsForbidden reports whether err is a SendGrid 403 response, usually caused
+// by an API key missing the required scopes.
+func IsForbidden(err error) bool {
+	return StatusCodeOf(err) == http.StatusForbidden
+}
+
+// IsRateLimited reports whether err is a SendGrid 429 response.
+func IsRateLimited(err error) bool {
+	return StatusCodeOf(err) == http.StatusTooManyRequests
+}
@@ -0,0 +1,85 @@
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorFromResponse(t *testing.T) {
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Header:     http.Header{"X-Request-Id": []string{"req-123"}},
+			Body:       io.NopCloser(strings.NewReader(`{"errors":[{"field":"name","message":"name is required"},{"message":"bad request"}]}`)),
+		}, nil
+	})
+
+	c, _ := NewClient("SG.test", WithTransport(transport))
+	_, err := c.CreateApiKey(context.Background(), ChildApiKey{Scopes: []string{"mail.send"}})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an *APIError in the chain, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", apiErr.StatusCode)
+	}
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("expected request id req-123, got %q", apiErr.RequestID)
+	}
+	if len(apiErr.Errors) != 2 || apiErr.Errors[0].Field != "name" || apiErr.Errors[0].Message != "name is required" {
+		t.Errorf("unexpected errors: %+v", apiErr.Errors)
+	}
+	if !strings.Contains(err.Error(), "name: name is required") {
+		t.Errorf("unexpected error message: %s", err)
+	}
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+	wrapped := fmt.Errorf("ReadApiKey: Bad Request: %w", notFound)
+
+	if !IsNotFound(wrapped) {
+		t.Errorf("expected IsNotFound to see through wrapping")
+	}
+	if IsRateLimited(wrapped) || IsForbidden(wrapped) {
+		t.Errorf("unexpected classification of a 404")
+	}
+	if !IsRateLimited(&APIError{StatusCode: http.StatusTooManyRequests}) {
+		t.Errorf("expected IsRateLimited for a 429")
+	}
+	if IsNotFound(errors.New("plain error")) {
+		t.Errorf("expected plain errors not to be classified")
+	}
+}
+
+func TestListLookupsReportNotFound(t *testing.T) {
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `[]`
+		if strings.HasSuffix(req.URL.Path, "/verified_senders") {
+			body = `{"results":[]}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})
+
+	c, _ := NewClient("SG.test", WithTransport(transport))
+
+	if _, err := c.ReadSingleSender(context.Background(), "42"); !IsNotFound(err) {
+		t.Errorf("ReadSingleSender: expected not found, got %v", err)
+	}
+	if _, err := c.FindDomainAuth(context.Background(), DomainAuth{Domain: "example.com"}); !IsNotFound(err) {
+		t.Errorf("FindDomainAuth: expected not found, got %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// EventWebhook holds the settings of the legacy event webhook, the account
+// has exactly one. The OAuth client secret is write only.
+type EventWebhook struct {
+	Enabled           bool   `json:"enabled"`
+	URL               string `json:"url"`
+	GroupResubscribe  bool   `json:"group_resubscribe"`
+	Delivered         bool   `json:"delivered"`
+	GroupUnsubscribe  bool   `json:"group_unsubscribe"`
+	SpamReport        bool   `json:"spam_report"`
+	Bounce            bool   `json:"bounce"`
+	Deferred          bool   `json:"deferred"`
+	Unsubscribe       bool   `json:"unsubscribe"`
+	Processed         bool   `json:"processed"`
+	Open              bool   `json:"open"`
+	Click             bool   `json:"click"`
+	Dropped           bool   `json:"dropped"`
+	OAuthClientID     string `json:"oauth_client_id"`
+	OAuthClientSecret string `json:"oauth_client_secret,omitempty"`
+	OAuthTokenURL     string `json:"oauth_token_url"`
+}
+
+func parseEventWebhook(respBody string) (*EventWebhook, error) {
+	var body EventWebhook
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing event webhook: %w", err)
+	}
+
+	return &body, nil
+}
+
+func parseEventWebhookSigning(respBody string) (string, error) {
+	var body struct {
+		PublicKey string `json:"public_key"`
+	}
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return "", fmt.Errorf("failed parsing event webhook signing: %w", err)
+	}
+
+	return body.PublicKey, nil
+}
+
+func (c *Client) ReadEventWebhook(ctx context.Context) (*EventWebhook, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/user/webhooks/event/settings")
+	if err != nil {
+		return nil, fmt.Errorf("ReadEventWebhook: %w", err)
+	}
+
+	return parseEventWebhook(respBody)
+}
+
+// UpdateEventWebhook replaces every setting of the event webhook.
+func (c *Client) UpdateEventWebhook(ctx context.Context, webhook EventWebhook) (*EventWebhook, error) {
+	respBody, _, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings", webhook)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateEventWebhook: %w", err)
+	}
+
+	return parseEventWebhook(respBody)
+}
+
+// ReadEventWebhookPublicKey returns the key to verify signed event webhook
+// requests with, it is empty when signing is disabled.
+func (c *Client) ReadEventWebhookPublicKey(ctx context.Context) (string, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/user/webhooks/event/settings/signed")
+	if err != nil {
+		return "", fmt.Errorf("ReadEventWebhookPublicKey: %w", err)
+	}
+
+	return parseEventWebhookSigning(respBody)
+}
+
+// SetEventWebhookSigning enables or disables signing of event webhook
+// requests and returns the public key, SendGrid generates a new key pair
+// every time signing is enabled.
+func (c *Client) SetEventWebhookSigning(ctx context.Context, enabled bool) (string, error) {
+	respBody, _, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings/signed", map[string]bool{"enabled": enabled})
+	if err != nil {
+		return "", fmt.Errorf("SetEventWebhookSigning: %w", err)
+	}
+
+	return parseEventWebhookSigning(respBody)
+}
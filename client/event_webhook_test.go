@@ -0,0 +1,52 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEventWebhookLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	if _, err := c.UpdateEventWebhook(ctx, EventWebhook{Enabled: true}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 without url, got %v", err)
+	}
+
+	webhook, err := c.UpdateEventWebhook(ctx, EventWebhook{
+		Enabled:           true,
+		URL:               "https://hooks.example.com/sendgrid",
+		Delivered:         true,
+		Bounce:            true,
+		OAuthClientID:     "client",
+		OAuthClientSecret: "secret",
+		OAuthTokenURL:     "https://auth.example.com/token",
+	})
+	if err != nil {
+		t.Fatalf("UpdateEventWebhook: %s", err)
+	}
+	if !webhook.Delivered || webhook.Open || webhook.OAuthClientSecret != "" {
+		t.Errorf("unexpected webhook: %+v", webhook)
+	}
+
+	webhook, err = c.ReadEventWebhook(ctx)
+	if err != nil {
+		t.Fatalf("ReadEventWebhook: %s", err)
+	}
+	if webhook.URL != "https://hooks.example.com/sendgrid" || webhook.OAuthClientID != "client" {
+		t.Errorf("unexpected webhook: %+v", webhook)
+	}
+
+	key, err := c.SetEventWebhookSigning(ctx, true)
+	if err != nil || key == "" {
+		t.Fatalf("SetEventWebhookSigning: %q, %v", key, err)
+	}
+	read, err := c.ReadEventWebhookPublicKey(ctx)
+	if err != nil || read != key {
+		t.Errorf("ReadEventWebhookPublicKey: %q, %v", read, err)
+	}
+
+	if key, err := c.SetEventWebhookSigning(ctx, false); err != nil || key != "" {
+		t.Errorf("expected no key once signing is disabled: %q, %v", key, err)
+	}
+}
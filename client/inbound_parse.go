@@ -0,0 +1,70 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// InboundParse posts the emails received by Hostname to URL. The MX record
+// of the hostname must point to mx.sendgrid.net.
+type InboundParse struct {
+	Hostname  string `json:"hostname,omitempty"`
+	URL       string `json:"url"`
+	SpamCheck bool   `json:"spam_check"`
+	SendRaw   bool   `json:"send_raw"`
+}
+
+func parseInboundParse(respBody string) (*InboundParse, error) {
+	var body InboundParse
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing inbound parse setting: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (c *Client) CreateInboundParse(ctx context.Context, parse InboundParse) (*InboundParse, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/user/webhooks/parse/settings", parse)
+	if err != nil {
+		return nil, fmt.Errorf("CreateInboundParse: %w", err)
+	}
+
+	return parseInboundParse(respBody)
+}
+
+func (c *Client) ReadInboundParse(ctx context.Context, hostname string) (*InboundParse, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/user/webhooks/parse/settings/"+url.PathEscape(hostname))
+	if err != nil {
+		return nil, fmt.Errorf("ReadInboundParse: %w", err)
+	}
+
+	return parseInboundParse(respBody)
+}
+
+// UpdateInboundParse updates the settings of parse.Hostname, the hostname
+// itself cannot be changed.
+func (c *Client) UpdateInboundParse(ctx context.Context, parse InboundParse) (*InboundParse, error) {
+	respBody, _, err := c.Post(ctx, "PATCH", "/user/webhooks/parse/settings/"+url.PathEscape(parse.Hostname), InboundParse{
+		URL:       parse.URL,
+		SpamCheck: parse.SpamCheck,
+		SendRaw:   parse.SendRaw,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UpdateInboundParse: %w", err)
+	}
+
+	return parseInboundParse(respBody)
+}
+
+func (c *Client) DeleteInboundParse(ctx context.Context, hostname string) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", "/user/webhooks/parse/settings/"+url.PathEscape(hostname))
+	if err != nil {
+		return false, fmt.Errorf("DeleteInboundParse: %w", err)
+	}
+
+	return true, nil
+}
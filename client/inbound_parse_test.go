@@ -0,0 +1,46 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestInboundParseLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	if _, err := c.CreateInboundParse(ctx, InboundParse{Hostname: "parse.example.com", URL: "https://hooks.example.com/parse"}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for an unauthenticated hostname, got %v", err)
+	}
+
+	if _, err := c.CreateDomainAuth(ctx, DomainAuth{Domain: "example.com"}); err != nil {
+		t.Fatalf("CreateDomainAuth: %s", err)
+	}
+
+	parse, err := c.CreateInboundParse(ctx, InboundParse{Hostname: "parse.example.com", URL: "https://hooks.example.com/parse", SpamCheck: true})
+	if err != nil {
+		t.Fatalf("CreateInboundParse: %s", err)
+	}
+	if parse.Hostname != "parse.example.com" || !parse.SpamCheck {
+		t.Errorf("unexpected setting: %+v", parse)
+	}
+
+	if _, err := c.UpdateInboundParse(ctx, InboundParse{Hostname: "parse.example.com", URL: "https://hooks.example.com/raw", SendRaw: true}); err != nil {
+		t.Fatalf("UpdateInboundParse: %s", err)
+	}
+
+	parse, err = c.ReadInboundParse(ctx, "parse.example.com")
+	if err != nil {
+		t.Fatalf("ReadInboundParse: %s", err)
+	}
+	if parse.URL != "https://hooks.example.com/raw" || parse.SpamCheck || !parse.SendRaw {
+		t.Errorf("unexpected setting: %+v", parse)
+	}
+
+	if _, err := c.DeleteInboundParse(ctx, "parse.example.com"); err != nil {
+		t.Fatalf("DeleteInboundParse: %s", err)
+	}
+	if _, err := c.ReadInboundParse(ctx, "parse.example.com"); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
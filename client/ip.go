@@ -0,0 +1,72 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// DedicatedIP is an IP address of the account. StartDate is the unix time
+// warmup started, zero unless Warmup is set. AssignedAt is zero for IPs
+// bought but not yet assigned to the account.
+type DedicatedIP struct {
+	IP           string   `json:"ip"`
+	Subusers     []string `json:"subusers,omitempty"`
+	Rdns         string   `json:"rdns,omitempty"`
+	Pools        []string `json:"pools"`
+	Warmup       bool     `json:"warmup"`
+	StartDate    int64    `json:"start_date,omitempty"`
+	Whitelabeled bool     `json:"whitelabeled"`
+	AssignedAt   int64    `json:"assigned_at,omitempty"`
+}
+
+func parseDedicatedIPs(respBody string) ([]DedicatedIP, error) {
+	var ips []DedicatedIP
+
+	err := json.Unmarshal([]byte(respBody), &ips)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing ips: %w", err)
+	}
+
+	return ips, nil
+}
+
+// ListIPs returns every IP of the account with its pools, warmup state and
+// the subusers it is assigned to.
+func (c *Client) ListIPs(ctx context.Context) ([]DedicatedIP, error) {
+	ips, err := newPaginator(c, "/ips", parseDedicatedIPs).all(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ListIPs: %w", err)
+	}
+
+	return ips, nil
+}
+
+// ListAssignedIPs returns the IPs assigned to the account. Subusers,
+// reverse DNS and the assignment time are not part of this listing.
+func (c *Client) ListAssignedIPs(ctx context.Context) ([]DedicatedIP, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/ips/assigned")
+	if err != nil {
+		return nil, fmt.Errorf("ListAssignedIPs: %w", err)
+	}
+
+	return parseDedicatedIPs(respBody)
+}
+
+// ReadIP reads an IP of the account, IPs the account does not own are not
+// found.
+func (c *Client) ReadIP(ctx context.Context, ip string) (*DedicatedIP, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/ips/"+url.PathEscape(ip))
+	if err != nil {
+		return nil, fmt.Errorf("ReadIP: %w", err)
+	}
+
+	var body DedicatedIP
+	err = json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("ReadIP: failed parsing ip: %w", err)
+	}
+
+	return &body, nil
+}
@@ -0,0 +1,111 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// IPPool is a named group of dedicated IPs mail can be sent from.
+type IPPool struct {
+	Name string     `json:"name"`
+	IPs  []IPPoolIP `json:"ips,omitempty"`
+}
+
+// IPPoolIP is an IP in a pool.
+type IPPoolIP struct {
+	IP        string `json:"ip"`
+	Warmup    bool   `json:"warmup"`
+	StartDate int64  `json:"start_date,omitempty"`
+}
+
+func parseIPPool(respBody string) (*IPPool, error) {
+	// a single pool is returned with its name as pool_name
+	var body struct {
+		IPPool
+		PoolName string `json:"pool_name"`
+	}
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing ip pool: %w", err)
+	}
+
+	pool := body.IPPool
+	if pool.Name == "" {
+		pool.Name = body.PoolName
+	}
+
+	return &pool, nil
+}
+
+func ipPoolEndpoint(name string) string {
+	return "/ips/pools/" + url.PathEscape(name)
+}
+
+func (c *Client) CreateIPPool(ctx context.Context, name string) (*IPPool, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/ips/pools", IPPool{Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("CreateIPPool: %w", err)
+	}
+
+	return parseIPPool(respBody)
+}
+
+// ReadIPPool reads a pool with the IPs in it.
+func (c *Client) ReadIPPool(ctx context.Context, name string) (*IPPool, error) {
+	respBody, _, err := c.Get(ctx, "GET", ipPoolEndpoint(name))
+	if err != nil {
+		return nil, fmt.Errorf("ReadIPPool: %w", err)
+	}
+
+	return parseIPPool(respBody)
+}
+
+// RenameIPPool renames a pool, the IPs stay in it.
+func (c *Client) RenameIPPool(ctx context.Context, name, newName string) (*IPPool, error) {
+	respBody, _, err := c.Post(ctx, "PUT", ipPoolEndpoint(name), IPPool{Name: newName})
+	if err != nil {
+		return nil, fmt.Errorf("RenameIPPool: %w", err)
+	}
+
+	return parseIPPool(respBody)
+}
+
+func (c *Client) DeleteIPPool(ctx context.Context, name string) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", ipPoolEndpoint(name))
+	if err != nil {
+		return false, fmt.Errorf("DeleteIPPool: %w", err)
+	}
+
+	return true, nil
+}
+
+// AddIPToPool adds an IP of the account to a pool. An IP can be in several
+// pools.
+func (c *Client) AddIPToPool(ctx context.Context, name, ip string) (*DedicatedIP, error) {
+	respBody, _, err := c.Post(ctx, "POST", ipPoolEndpoint(name)+"/ips", struct {
+		IP string `json:"ip"`
+	}{ip})
+	if err != nil {
+		return nil, fmt.Errorf("AddIPToPool: %w", err)
+	}
+
+	var body DedicatedIP
+	err = json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("AddIPToPool: failed parsing ip: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (c *Client) RemoveIPFromPool(ctx context.Context, name, ip string) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", ipPoolEndpoint(name)+"/ips/"+url.PathEscape(ip))
+	if err != nil {
+		return false, fmt.Errorf("RemoveIPFromPool: %w", err)
+	}
+
+	return true, nil
+}
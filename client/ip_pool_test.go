@@ -0,0 +1,65 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+func TestIPPoolLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+	ip := sendgridtest.DedicatedIPs[0]
+
+	pool, err := c.CreateIPPool(ctx, "marketing")
+	if err != nil {
+		t.Fatalf("CreateIPPool: %s", err)
+	}
+	if pool.Name != "marketing" {
+		t.Errorf("unexpected pool: %+v", pool)
+	}
+	if _, err := c.CreateIPPool(ctx, "marketing"); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for a duplicate name, got %v", err)
+	}
+
+	added, err := c.AddIPToPool(ctx, "marketing", ip)
+	if err != nil {
+		t.Fatalf("AddIPToPool: %s", err)
+	}
+	if added.IP != ip || len(added.Pools) != 1 {
+		t.Errorf("unexpected ip: %+v", added)
+	}
+	if _, err := c.AddIPToPool(ctx, "marketing", "198.51.100.1"); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for an IP outside the account, got %v", err)
+	}
+
+	if _, err := c.RenameIPPool(ctx, "marketing", "newsletters"); err != nil {
+		t.Fatalf("RenameIPPool: %s", err)
+	}
+	if _, err := c.ReadIPPool(ctx, "marketing"); !IsNotFound(err) {
+		t.Errorf("expected the old name to be gone, got %v", err)
+	}
+
+	pool, err = c.ReadIPPool(ctx, "newsletters")
+	if err != nil {
+		t.Fatalf("ReadIPPool: %s", err)
+	}
+	if pool.Name != "newsletters" || len(pool.IPs) != 1 || pool.IPs[0].IP != ip {
+		t.Errorf("expected the IP to move with the pool: %+v", pool)
+	}
+
+	if _, err := c.RemoveIPFromPool(ctx, "newsletters", ip); err != nil {
+		t.Fatalf("RemoveIPFromPool: %s", err)
+	}
+	if _, err := c.RemoveIPFromPool(ctx, "newsletters", ip); !IsNotFound(err) {
+		t.Errorf("expected not found for an IP outside the pool, got %v", err)
+	}
+
+	if _, err := c.DeleteIPPool(ctx, "newsletters"); err != nil {
+		t.Fatalf("DeleteIPPool: %s", err)
+	}
+	if _, err := c.ReadIPPool(ctx, "newsletters"); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+func TestListIPs(t *testing.T) {
+	c, _ := newFakeClient(t)
+	c.PageSize = 3
+	ctx := context.Background()
+	ip := sendgridtest.DedicatedIPs[1]
+
+	if _, err := c.CreateIPPool(ctx, "transactional"); err != nil {
+		t.Fatalf("CreateIPPool: %s", err)
+	}
+	if _, err := c.AddIPToPool(ctx, "transactional", ip); err != nil {
+		t.Fatalf("AddIPToPool: %s", err)
+	}
+	if _, err := c.CreateSubuser(ctx, Subuser{Username: "subuser1", Email: "subuser1@example.com", Password: "secret", Ips: []string{ip}}); err != nil {
+		t.Fatalf("CreateSubuser: %s", err)
+	}
+
+	ips, err := c.ListIPs(ctx)
+	if err != nil {
+		t.Fatalf("ListIPs: %s", err)
+	}
+	if len(ips) != len(sendgridtest.DedicatedIPs) {
+		t.Fatalf("expected every IP over two pages, got %+v", ips)
+	}
+	for _, listed := range ips {
+		if listed.IP != ip {
+			continue
+		}
+		if len(listed.Pools) != 1 || listed.Pools[0] != "transactional" {
+			t.Errorf("expected the pool to be listed: %+v", listed)
+		}
+		if len(listed.Subusers) != 1 || listed.Subusers[0] != "subuser1" {
+			t.Errorf("expected the subuser to be listed: %+v", listed)
+		}
+	}
+
+	assigned, err := c.ListAssignedIPs(ctx)
+	if err != nil {
+		t.Fatalf("ListAssignedIPs: %s", err)
+	}
+	if len(assigned) != len(sendgridtest.DedicatedIPs)-1 {
+		t.Errorf("expected the unassigned IP to be left out, got %+v", assigned)
+	}
+}
+
+func TestReadIP(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	ip, err := c.ReadIP(ctx, sendgridtest.DedicatedIPs[0])
+	if err != nil {
+		t.Fatalf("ReadIP: %s", err)
+	}
+	if ip.IP != sendgridtest.DedicatedIPs[0] || ip.AssignedAt == 0 {
+		t.Errorf("unexpected ip: %+v", ip)
+	}
+
+	if _, err := c.ReadIP(ctx, "198.51.100.1"); !IsNotFound(err) {
+		t.Errorf("expected not found for an IP outside the account, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// IPWarmup is a dedicated IP in warmup. SendGrid raises the number of
+// emails sent from the IP gradually from StartDate, a unix time, on.
+type IPWarmup struct {
+	IP        string `json:"ip"`
+	StartDate int64  `json:"start_date,omitempty"`
+}
+
+// parseIPWarmup returns the single IP of a warmup response, which is always
+// a list. An empty list means the IP is not in warmup.
+func parseIPWarmup(respBody string) (*IPWarmup, error) {
+	var body []IPWarmup
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing ip warmup: %w", err)
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("ip warmup: empty response: %w", ErrNotFound)
+	}
+
+	return &body[0], nil
+}
+
+func (c *Client) StartIPWarmup(ctx context.Context, ip string) (*IPWarmup, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/ips/warmup", IPWarmup{IP: ip})
+	if err != nil {
+		return nil, fmt.Errorf("StartIPWarmup: %w", err)
+	}
+
+	return parseIPWarmup(respBody)
+}
+
+// ReadIPWarmup reads the warmup of an IP. IPs not in warmup are not found.
+func (c *Client) ReadIPWarmup(ctx context.Context, ip string) (*IPWarmup, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/ips/warmup/"+url.PathEscape(ip))
+	if err != nil {
+		return nil, fmt.Errorf("ReadIPWarmup: %w", err)
+	}
+
+	return parseIPWarmup(respBody)
+}
+
+func (c *Client) StopIPWarmup(ctx context.Context, ip string) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", "/ips/warmup/"+url.PathEscape(ip))
+	if err != nil {
+		return false, fmt.Errorf("StopIPWarmup: %w", err)
+	}
+
+	return true, nil
+}
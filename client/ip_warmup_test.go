@@ -0,0 +1,70 @@
+package sendgrid
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+func TestIPWarmupLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+	ip := sendgridtest.DedicatedIPs[0]
+
+	if _, err := c.ReadIPWarmup(ctx, ip); !IsNotFound(err) {
+		t.Errorf("expected not found before warmup, got %v", err)
+	}
+
+	started, err := c.StartIPWarmup(ctx, ip)
+	if err != nil {
+		t.Fatalf("StartIPWarmup: %s", err)
+	}
+	if started.IP != ip || started.StartDate == 0 {
+		t.Errorf("unexpected warmup: %+v", started)
+	}
+	if _, err := c.StartIPWarmup(ctx, ip); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for an IP already in warmup, got %v", err)
+	}
+
+	read, err := c.ReadIPWarmup(ctx, ip)
+	if err != nil {
+		t.Fatalf("ReadIPWarmup: %s", err)
+	}
+	if *read != *started {
+		t.Errorf("expected %+v, got %+v", started, read)
+	}
+
+	dedicated, err := c.ReadIP(ctx, ip)
+	if err != nil {
+		t.Fatalf("ReadIP: %s", err)
+	}
+	if !dedicated.Warmup || dedicated.StartDate != started.StartDate {
+		t.Errorf("expected the IP to be in warmup: %+v", dedicated)
+	}
+
+	if _, err := c.StopIPWarmup(ctx, ip); err != nil {
+		t.Fatalf("StopIPWarmup: %s", err)
+	}
+	if _, err := c.ReadIPWarmup(ctx, ip); !IsNotFound(err) {
+		t.Errorf("expected not found after stopping warmup, got %v", err)
+	}
+}
+
+func TestReadIPWarmupEmptyResponse(t *testing.T) {
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`[]`)),
+		}, nil
+	})
+
+	c, _ := NewClient("SG.test", WithTransport(transport))
+	if _, err := c.ReadIPWarmup(context.Background(), "192.0.2.1"); !IsNotFound(err) {
+		t.Errorf("expected not found for an empty response, got %v", err)
+	}
+}
@@ -3,10 +3,8 @@ package sendgrid
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 )
 
@@ -29,6 +27,17 @@ type Result struct {
 	Result Ipmgmt `json:"result"`
 }
 
+// whitelist pages through /access_settings/whitelist.
+func (c *Client) whitelist() *paginator[Ipmgmt] {
+	return newPaginator(c, "/access_settings/whitelist", func(respBody string) ([]Ipmgmt, error) {
+		var getipList IPsresult
+		if err := json.Unmarshal([]byte(respBody), &getipList); err != nil {
+			return nil, fmt.Errorf("getallips: failed parsing ipmgmt: %w", err)
+		}
+		return getipList.Ips, nil
+	})
+}
+
 func (c *Client) GetIPMgmt(ctx context.Context, ipmgmtid string) (*Result, error) {
 
 	var getipResult Result
@@ -41,7 +50,7 @@ func (c *Client) GetIPMgmt(ctx context.Context, ipmgmtid string) (*Result, error
 
 		respBody, _, err := c.Get(ctx, "GET", "/access_settings/whitelist/"+ipmgmtid)
 		if err != nil {
-			return nil, errors.New("Read Data[GetByIP]: Bad Request:" + err.Error())
+			return nil, fmt.Errorf("Read Data[GetByIP]: Bad Request: %w", err)
 		}
 
 		err = json.Unmarshal([]byte(respBody), &getipResult)
@@ -49,24 +58,17 @@ func (c *Client) GetIPMgmt(ctx context.Context, ipmgmtid string) (*Result, error
 			return nil, fmt.Errorf("GetIPMgmt: failed parsing ipmgmt: %w", err)
 		}
 	} else {
-		respBody, _, err := c.Get(ctx, "GET", "/access_settings/whitelist")
+		ipitem, found, err := c.whitelist().find(ctx, func(ipitem Ipmgmt) bool {
+			return ipitem.IP == ipmgmtid
+		})
 		if err != nil {
-			return nil, errors.New("getallips: Bad Request:" + err.Error())
+			return nil, fmt.Errorf("getallips: Bad Request: %w", err)
 		}
 
-		var getipList IPsresult
-		err = json.Unmarshal([]byte(respBody), &getipList)
-		if err != nil {
-			return nil, fmt.Errorf("getallips: failed parsing ipmgmt: %w", err)
-		}
-
-		for _, ipitem := range getipList.Ips {
-			tempItem := ipitem.IP
-			//tflog.Debug(ctx, "COMPARE", map[string]interface{}{"ipmgmtid": ipmgmtid, "tempItem": tempItem})
-			if tempItem == ipmgmtid {
-				getipResult.Result = ipitem
-			}
+		if !found {
+			return nil, fmt.Errorf("getallips: ip %s: %w", ipmgmtid, ErrNotFound)
 		}
+		getipResult.Result = ipitem
 	}
 
 	return &getipResult, nil
@@ -81,8 +83,8 @@ func (c *Client) CreateIPMgmt(ctx context.Context, ips Ipmgmt) (*Ipmgmt, error)
 	collectedips = append(collectedips, ips)
 	respBody, statusCode, err := c.Post(ctx, "POST", "/access_settings/whitelist", Ips{IPS: collectedips})
 
-	if err != nil && statusCode == 400 {
-		return nil, errors.New("CreateIPMgMt: Bad Request:" + strconv.Itoa(statusCode) + "," + err.Error())
+	if err != nil {
+		return nil, fmt.Errorf("CreateIPMgMt: Bad Request:%d, %w", statusCode, err)
 	}
 
 	getResult := IPsresult{}
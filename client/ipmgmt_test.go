@@ -0,0 +1,67 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestIPMgmtLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	created, err := c.CreateIPMgmt(ctx, Ipmgmt{IP: "192.0.2.10/32"})
+	if err != nil {
+		t.Fatalf("CreateIPMgmt: %s", err)
+	}
+	if created.ID == 0 || created.IP != "192.0.2.10/32" {
+		t.Errorf("unexpected ip: %+v", created)
+	}
+
+	id := fmt.Sprint(created.ID)
+	byID, err := c.GetIPMgmt(ctx, id)
+	if err != nil {
+		t.Fatalf("GetIPMgmt by id: %s", err)
+	}
+	byIP, err := c.GetIPMgmt(ctx, "192.0.2.10/32")
+	if err != nil {
+		t.Fatalf("GetIPMgmt by ip: %s", err)
+	}
+	if byID.Result.ID != created.ID || byIP.Result.ID != created.ID {
+		t.Errorf("lookups disagree: %+v, %+v", byID.Result, byIP.Result)
+	}
+
+	if _, err := c.DeleteIPMgmt(ctx, id); err != nil {
+		t.Fatalf("DeleteIPMgmt: %s", err)
+	}
+	if _, err := c.GetIPMgmt(ctx, id); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestIPMgmtLookupByIPIsPaged(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+	c.PageSize = 2
+
+	var last *Ipmgmt
+	for i := 1; i <= 5; i++ {
+		created, err := c.CreateIPMgmt(ctx, Ipmgmt{IP: fmt.Sprintf("192.0.2.%d/32", i)})
+		if err != nil {
+			t.Fatalf("CreateIPMgmt: %s", err)
+		}
+		last = created
+	}
+
+	requested := recordRequests(c)
+	found, err := c.GetIPMgmt(ctx, "192.0.2.5/32")
+	if err != nil {
+		t.Fatalf("GetIPMgmt by ip: %s", err)
+	}
+	if found.Result.ID != last.ID {
+		t.Errorf("expected the last ip, got %+v", found.Result)
+	}
+	if len(*requested) != 3 {
+		t.Errorf("expected 3 pages, got %v", *requested)
+	}
+}
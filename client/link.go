@@ -46,7 +46,7 @@ func (c *Client) CreateLinkBrand(ctx context.Context, domainauth LinkAuth) (*Lin
 	var linkauthresp LinkAuth
 	err = json.Unmarshal([]byte(createrespBody), &linkauthresp)
 	if err != nil {
-		return nil, fmt.Errorf("createlinkbrand: link branding creation failed:%s", err.Error())
+		return nil, fmt.Errorf("createlinkbrand: link branding creation failed: %w", err)
 	}
 
 	//return c.GetDomainAuth(ctx, domainauthresp)
@@ -76,13 +76,13 @@ func (c *Client) Updatelinkbrand(ctx context.Context, updatedetails LinkAuth) (*
 		Defaultdomain: updatedetails.Defaultdomain,
 	})
 	if err != nil && statuscode != http.StatusOK {
-		return nil, fmt.Errorf("updatelinkbrand: default domain update failed:%s", err.Error())
+		return nil, fmt.Errorf("updatelinkbrand: default domain update failed: %w", err)
 	}
 
 	var updatebrandresp LinkAuth
 	err = json.Unmarshal([]byte(updatebranddets), &updatebrandresp)
 	if err != nil {
-		return nil, fmt.Errorf("updatedomainauth: domain update failed:%s", err.Error())
+		return nil, fmt.Errorf("updatedomainauth: domain update failed: %w", err)
 	}
 	return &updatebrandresp, nil
 }
@@ -92,12 +92,12 @@ func (c *Client) Validatelinkbrand(ctx context.Context, validatedetails LinkAuth
 	//	return nil, fmt.Errorf("sk you are here")
 	validbrand, statuscode, err := c.Post(ctx, "POST", "/whitelabel/links/"+fmt.Sprintf("%d", validatedetails.ID)+"/validate", nil)
 	if err != nil && statuscode != http.StatusOK {
-		return nil, fmt.Errorf("updatelinkbrand: link branding validation failed:%s", err.Error())
+		return nil, fmt.Errorf("updatelinkbrand: link branding validation failed: %w", err)
 	}
 	var validatebrandresp LinkAuth
 	err = json.Unmarshal([]byte(validbrand), &validatebrandresp)
 	if err != nil {
-		return nil, fmt.Errorf("updatelinkbrand: Unable to unmarshal data:%s", err.Error())
+		return nil, fmt.Errorf("updatelinkbrand: Unable to unmarshal data: %w", err)
 	}
 
 	return c.Getlinkbrand(ctx, validatebrandresp)
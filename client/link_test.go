@@ -0,0 +1,43 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestLinkBrandLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	created, err := c.CreateLinkBrand(ctx, LinkAuth{Domain: "example.com", Subdomain: "links"})
+	if err != nil {
+		t.Fatalf("CreateLinkBrand: %s", err)
+	}
+	if created.ID == 0 || created.DNSDetails.DCNAME.Host != "links.example.com" {
+		t.Errorf("unexpected link brand: %+v", created)
+	}
+
+	updated, err := c.Updatelinkbrand(ctx, LinkAuth{ID: created.ID, Defaultdomain: true})
+	if err != nil {
+		t.Fatalf("Updatelinkbrand: %s", err)
+	}
+	if !updated.Defaultdomain {
+		t.Errorf("expected default link brand: %+v", updated)
+	}
+
+	validated, err := c.Validatelinkbrand(ctx, LinkAuth{ID: created.ID})
+	if err != nil {
+		t.Fatalf("Validatelinkbrand: %s", err)
+	}
+	if !validated.Valid || !validated.DNSDetails.OCNAME.Valid {
+		t.Errorf("expected valid link brand: %+v", validated)
+	}
+
+	if _, err := c.Deletelinkbrand(ctx, fmt.Sprint(created.ID)); err != nil {
+		t.Fatalf("Deletelinkbrand: %s", err)
+	}
+	if _, err := c.Getlinkbrand(ctx, LinkAuth{ID: created.ID}); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
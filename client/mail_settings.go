@@ -0,0 +1,92 @@
+package sendgrid
+
+import (
+	"context"
+)
+
+// MailSettingBCC sends a blind carbon copy of every email to Email.
+type MailSettingBCC struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+// MailSettingFooter appends a footer to every email.
+type MailSettingFooter struct {
+	Enabled      bool   `json:"enabled"`
+	HTMLContent  string `json:"html_content"`
+	PlainContent string `json:"plain_content"`
+}
+
+// MailSettingBouncePurge deletes bounces from the bounce list after a number
+// of days. Zero leaves the number of days unchanged.
+type MailSettingBouncePurge struct {
+	Enabled     bool  `json:"enabled"`
+	SoftBounces int64 `json:"soft_bounces,omitempty"`
+	HardBounces int64 `json:"hard_bounces,omitempty"`
+}
+
+// MailSettingForward forwards spam reports or bounces to Email, a comma
+// separated list of addresses.
+type MailSettingForward struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+// MailSettingAddressWhitelist lists the emails and domains that are never
+// suppressed.
+type MailSettingAddressWhitelist struct {
+	Enabled bool     `json:"enabled"`
+	List    []string `json:"list"`
+}
+
+func (c *Client) ReadMailSettingBCC(ctx context.Context) (*MailSettingBCC, error) {
+	return readSetting[MailSettingBCC](ctx, c, "ReadMailSettingBCC", "/mail_settings/bcc")
+}
+
+func (c *Client) UpdateMailSettingBCC(ctx context.Context, setting MailSettingBCC) (*MailSettingBCC, error) {
+	return updateSetting(ctx, c, "UpdateMailSettingBCC", "/mail_settings/bcc", setting)
+}
+
+func (c *Client) ReadMailSettingFooter(ctx context.Context) (*MailSettingFooter, error) {
+	return readSetting[MailSettingFooter](ctx, c, "ReadMailSettingFooter", "/mail_settings/footer")
+}
+
+func (c *Client) UpdateMailSettingFooter(ctx context.Context, setting MailSettingFooter) (*MailSettingFooter, error) {
+	return updateSetting(ctx, c, "UpdateMailSettingFooter", "/mail_settings/footer", setting)
+}
+
+func (c *Client) ReadMailSettingBouncePurge(ctx context.Context) (*MailSettingBouncePurge, error) {
+	return readSetting[MailSettingBouncePurge](ctx, c, "ReadMailSettingBouncePurge", "/mail_settings/bounce_purge")
+}
+
+func (c *Client) UpdateMailSettingBouncePurge(ctx context.Context, setting MailSettingBouncePurge) (*MailSettingBouncePurge, error) {
+	return updateSetting(ctx, c, "UpdateMailSettingBouncePurge", "/mail_settings/bounce_purge", setting)
+}
+
+func (c *Client) ReadMailSettingForwardSpam(ctx context.Context) (*MailSettingForward, error) {
+	return readSetting[MailSettingForward](ctx, c, "ReadMailSettingForwardSpam", "/mail_settings/forward_spam")
+}
+
+func (c *Client) UpdateMailSettingForwardSpam(ctx context.Context, setting MailSettingForward) (*MailSettingForward, error) {
+	return updateSetting(ctx, c, "UpdateMailSettingForwardSpam", "/mail_settings/forward_spam", setting)
+}
+
+func (c *Client) ReadMailSettingForwardBounce(ctx context.Context) (*MailSettingForward, error) {
+	return readSetting[MailSettingForward](ctx, c, "ReadMailSettingForwardBounce", "/mail_settings/forward_bounce")
+}
+
+func (c *Client) UpdateMailSettingForwardBounce(ctx context.Context, setting MailSettingForward) (*MailSettingForward, error) {
+	return updateSetting(ctx, c, "UpdateMailSettingForwardBounce", "/mail_settings/forward_bounce", setting)
+}
+
+func (c *Client) ReadMailSettingAddressWhitelist(ctx context.Context) (*MailSettingAddressWhitelist, error) {
+	return readSetting[MailSettingAddressWhitelist](ctx, c, "ReadMailSettingAddressWhitelist", "/mail_settings/address_whitelist")
+}
+
+// UpdateMailSettingAddressWhitelist replaces the whole list.
+func (c *Client) UpdateMailSettingAddressWhitelist(ctx context.Context, setting MailSettingAddressWhitelist) (*MailSettingAddressWhitelist, error) {
+	if setting.List == nil {
+		setting.List = []string{}
+	}
+	return updateSetting(ctx, c, "UpdateMailSettingAddressWhitelist", "/mail_settings/address_whitelist", setting)
+}
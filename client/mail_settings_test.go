@@ -0,0 +1,61 @@
+package sendgrid
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestMailSettings(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	if _, err := c.UpdateMailSettingBCC(ctx, MailSettingBCC{Enabled: true}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 without email, got %v", err)
+	}
+
+	footer, err := c.UpdateMailSettingFooter(ctx, MailSettingFooter{Enabled: true, HTMLContent: "<p>Example Inc.</p>", PlainContent: "Example Inc."})
+	if err != nil {
+		t.Fatalf("UpdateMailSettingFooter: %s", err)
+	}
+	if !footer.Enabled || footer.PlainContent != "Example Inc." {
+		t.Errorf("unexpected footer: %+v", footer)
+	}
+
+	if _, err := c.UpdateMailSettingBouncePurge(ctx, MailSettingBouncePurge{Enabled: true, SoftBounces: 5, HardBounces: 30}); err != nil {
+		t.Fatalf("UpdateMailSettingBouncePurge: %s", err)
+	}
+	// zero days leave the number of days unchanged
+	if _, err := c.UpdateMailSettingBouncePurge(ctx, MailSettingBouncePurge{Enabled: false}); err != nil {
+		t.Fatalf("UpdateMailSettingBouncePurge: %s", err)
+	}
+	purge, err := c.ReadMailSettingBouncePurge(ctx)
+	if err != nil {
+		t.Fatalf("ReadMailSettingBouncePurge: %s", err)
+	}
+	if purge.Enabled || purge.SoftBounces != 5 || purge.HardBounces != 30 {
+		t.Errorf("unexpected bounce purge: %+v", purge)
+	}
+
+	if _, err := c.UpdateMailSettingForwardSpam(ctx, MailSettingForward{Enabled: true, Email: "abuse@example.com"}); err != nil {
+		t.Fatalf("UpdateMailSettingForwardSpam: %s", err)
+	}
+	bounce, err := c.ReadMailSettingForwardBounce(ctx)
+	if err != nil {
+		t.Fatalf("ReadMailSettingForwardBounce: %s", err)
+	}
+	if bounce.Enabled || bounce.Email != "" {
+		t.Errorf("expected forward bounce to be untouched: %+v", bounce)
+	}
+
+	if _, err := c.UpdateMailSettingAddressWhitelist(ctx, MailSettingAddressWhitelist{Enabled: true, List: []string{"example.com"}}); err != nil {
+		t.Fatalf("UpdateMailSettingAddressWhitelist: %s", err)
+	}
+	allowed, err := c.UpdateMailSettingAddressWhitelist(ctx, MailSettingAddressWhitelist{Enabled: false})
+	if err != nil {
+		t.Fatalf("UpdateMailSettingAddressWhitelist: %s", err)
+	}
+	if !reflect.DeepEqual(allowed.List, []string{}) {
+		t.Errorf("expected the list to be cleared: %+v", allowed)
+	}
+}
@@ -0,0 +1,193 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultPageSize is the number of items requested per page of a list
+// endpoint.
+const DefaultPageSize = 100
+
+// WithPageSize sets the number of items requested per page of a list
+// endpoint.
+func WithPageSize(pageSize int) Option {
+	return func(c *Client) {
+		if pageSize > 0 {
+			c.PageSize = pageSize
+		}
+	}
+}
+
+// paginator walks every page of a list endpoint. The page after the current
+// one is taken from the Link header when SendGrid sends one. Otherwise it is
+// requested with the cursor parameter if set, or with limit and offset.
+type paginator[T any] struct {
+	client   *Client
+	endpoint string
+	// decode extracts the items of one page from the response body.
+	decode func(body string) ([]T, error)
+	// cursor returns the query parameter and value selecting the items after
+	// last, for endpoints that do not support offset.
+	cursor func(last T) (string, string)
+}
+
+func newPaginator[T any](c *Client, endpoint string, decode func(body string) ([]T, error)) *paginator[T] {
+	return &paginator[T]{client: c, endpoint: endpoint, decode: decode}
+}
+
+// each calls fn for every item of every page until fn returns false.
+func (p *paginator[T]) each(ctx context.Context, fn func(item T) bool) error {
+	pageSize := p.client.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	endpoint := withQuery(p.endpoint, url.Values{"limit": {strconv.Itoa(pageSize)}})
+	seen := map[string]bool{}
+	offset := 0
+	previous := ""
+
+	for {
+		if seen[endpoint] {
+			return fmt.Errorf("paginate %s: page %s requested twice", p.endpoint, endpoint)
+		}
+		seen[endpoint] = true
+
+		resp, err := p.client.getResponse(ctx, "GET", endpoint)
+		if err != nil {
+			return err
+		}
+		// an endpoint ignoring both offset and cursor returns the first page
+		// over and over
+		if previous != "" && resp.Body == previous {
+			return fmt.Errorf("paginate %s: endpoint does not support paging", p.endpoint)
+		}
+		previous = resp.Body
+
+		items, err := p.decode(resp.Body)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if !fn(item) {
+				return nil
+			}
+		}
+
+		if next, ok := p.client.nextLink(http.Header(resp.Headers)); ok {
+			endpoint = next
+			continue
+		}
+
+		if len(items) < pageSize {
+			return nil
+		}
+
+		offset += len(items)
+		if p.cursor != nil {
+			key, value := p.cursor(items[len(items)-1])
+			endpoint = withQuery(p.endpoint, url.Values{"limit": {strconv.Itoa(pageSize)}, key: {value}})
+		} else {
+			endpoint = withQuery(p.endpoint, url.Values{"limit": {strconv.Itoa(pageSize)}, "offset": {strconv.Itoa(offset)}})
+		}
+	}
+}
+
+// all returns the items of every page.
+func (p *paginator[T]) all(ctx context.Context) ([]T, error) {
+	var items []T
+	err := p.each(ctx, func(item T) bool {
+		items = append(items, item)
+		return true
+	})
+	return items, err
+}
+
+// find returns the first item matching match, fetching no more pages than
+// needed.
+func (p *paginator[T]) find(ctx context.Context, match func(item T) bool) (T, bool, error) {
+	var found T
+	ok := false
+	err := p.each(ctx, func(item T) bool {
+		if match(item) {
+			found, ok = item, true
+			return false
+		}
+		return true
+	})
+	return found, ok, err
+}
+
+// nextLink returns the endpoint of the rel="next" entry of a Link header,
+// relative to the client base URL.
+func (c *Client) nextLink(header http.Header) (string, bool) {
+	for _, value := range header.Values("Link") {
+		for _, entry := range strings.Split(value, ",") {
+			parts := strings.Split(entry, ";")
+			target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			if target == "" {
+				continue
+			}
+
+			isNext := false
+			for _, param := range parts[1:] {
+				param = strings.ReplaceAll(strings.TrimSpace(param), `"`, "")
+				if param == "rel=next" {
+					isNext = true
+				}
+			}
+			if !isNext {
+				continue
+			}
+
+			if endpoint, ok := c.relativeEndpoint(target); ok {
+				return endpoint, true
+			}
+		}
+	}
+	return "", false
+}
+
+// relativeEndpoint strips the path of the client base URL from target.
+func (c *Client) relativeEndpoint(target string) (string, bool) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return "", false
+	}
+	base, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return "", false
+	}
+
+	endpoint := u.Path
+	if strings.HasPrefix(endpoint, base.Path) {
+		endpoint = strings.TrimPrefix(endpoint, base.Path)
+	}
+	if !strings.HasPrefix(endpoint, "/") {
+		endpoint = "/" + endpoint
+	}
+	if u.RawQuery != "" {
+		endpoint += "?" + u.RawQuery
+	}
+	return endpoint, true
+}
+
+// withQuery adds params to the query string of endpoint, replacing values
+// of the same name.
+func withQuery(endpoint string, params url.Values) string {
+	path, rawQuery, _ := strings.Cut(endpoint, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		query = url.Values{}
+	}
+	for key, values := range params {
+		query[key] = values
+	}
+	return path + "?" + query.Encode()
+}
@@ -0,0 +1,178 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestPaginatorFollowsLinkHeader(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	var last *DomainAuth
+	for i := 0; i < 5; i++ {
+		d, err := c.CreateDomainAuth(ctx, DomainAuth{Domain: fmt.Sprintf("example%d.com", i)})
+		if err != nil {
+			t.Fatalf("CreateDomainAuth: %s", err)
+		}
+		last = d
+	}
+
+	requested := recordRequests(c)
+	c.PageSize = 2
+
+	domains, err := c.domains(nil).all(ctx)
+	if err != nil {
+		t.Fatalf("all: %s", err)
+	}
+	if len(domains) != 5 || domains[4].ID != last.ID {
+		t.Errorf("unexpected domains: %+v", domains)
+	}
+	if len(*requested) != 3 {
+		t.Errorf("expected 3 pages, got %v", *requested)
+	}
+}
+
+// recordRequests makes c record the URI of every request it sends.
+func recordRequests(c *Client) *[]string {
+	var requested []string
+	transport := c.HTTPClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	c.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requested = append(requested, req.URL.RequestURI())
+		return transport.RoundTrip(req)
+	})
+	return &requested
+}
+
+func TestPaginatorCursor(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+	c.PageSize = 2
+
+	var last *ReturnSinglesender
+	for i := 0; i < 5; i++ {
+		sender, err := c.CreateSingleSender(ctx, Singlesender{
+			Nickname:  fmt.Sprintf("sender%d", i),
+			FromEmail: fmt.Sprintf("sender%d@example.com", i),
+			ReplyTo:   "reply@example.com",
+			Address:   "1 Main Street",
+			City:      "Denver",
+			Country:   "USA",
+		})
+		if err != nil {
+			t.Fatalf("CreateSingleSender: %s", err)
+		}
+		last = sender
+	}
+
+	found, err := c.ReadSingleSender(ctx, fmt.Sprint(last.ID))
+	if err != nil {
+		t.Fatalf("ReadSingleSender: %s", err)
+	}
+	if found.Nickname != "sender4" {
+		t.Errorf("unexpected sender: %+v", found)
+	}
+}
+
+func TestPaginatorOffset(t *testing.T) {
+	const total = 7
+
+	c, err := NewClient("SG.test", WithPageSize(3), WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+
+		var users []User
+		for i := offset; i < offset+limit && i < total; i++ {
+			users = append(users, User{Username: fmt.Sprintf("user%d", i), Email: fmt.Sprintf("user%d@example.com", i)})
+		}
+		body, _ := json.Marshal(Users{Result: users})
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(string(body))),
+		}, nil
+	})))
+	if err != nil {
+		t.Fatalf("NewClient: %s", err)
+	}
+
+	users, err := c.teammates().all(context.Background())
+	if err != nil {
+		t.Fatalf("all: %s", err)
+	}
+	if len(users) != total || users[total-1].Username != "user6" {
+		t.Errorf("unexpected users: %+v", users)
+	}
+
+	username, err := c.GetUsernameByEmail(context.Background(), "user5@example.com")
+	if err != nil || username != "user5" {
+		t.Errorf("GetUsernameByEmail: %q, %v", username, err)
+	}
+}
+
+func TestPaginatorStopsWhenPagingIsIgnored(t *testing.T) {
+	c, err := NewClient("SG.test", WithPageSize(2), WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"result":[{"username":"a"},{"username":"b"}]}`)),
+		}, nil
+	})))
+	if err != nil {
+		t.Fatalf("NewClient: %s", err)
+	}
+
+	if _, err := c.teammates().all(context.Background()); err == nil {
+		t.Error("expected an error for an endpoint ignoring offset")
+	}
+}
+
+func TestNextLink(t *testing.T) {
+	c, err := NewClient("SG.test")
+	if err != nil {
+		t.Fatalf("NewClient: %s", err)
+	}
+
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{
+			name:     "next and prev",
+			header:   `<https://api.sendgrid.com/v3/teammates?limit=2&offset=0>; rel="prev"; title="1", <https://api.sendgrid.com/v3/teammates?limit=2&offset=4>; rel="next"; title="3"`,
+			expected: "/teammates?limit=2&offset=4",
+		},
+		{
+			name:   "last page",
+			header: `<https://api.sendgrid.com/v3/teammates?limit=2&offset=0>; rel="first"; title="1"`,
+		},
+		{
+			name: "no header",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.header != "" {
+				header.Set("Link", tt.header)
+			}
+
+			next, ok := c.nextLink(header)
+			if ok != (tt.expected != "") || next != tt.expected {
+				t.Errorf("expected %q, got %q (%t)", tt.expected, next, ok)
+			}
+		})
+	}
+}
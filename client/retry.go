@@ -0,0 +1,139 @@
+package sendgrid
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	backoff "github.com/cenkalti/backoff"
+	"github.com/sendgrid/rest"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// WithRetry sets how often a failed request is retried and the maximum
+// time to wait between two attempts.
+func WithRetry(maxRetries int, maxWait time.Duration) Option {
+	return func(c *Client) {
+		if maxRetries >= 0 {
+			c.MaxRetries = maxRetries
+		}
+		if maxWait > 0 {
+			c.RetryMaxWait = maxWait
+		}
+	}
+}
+
+// sendWithRetry executes req and retries it on rate limiting, server errors
+// and network errors. Server and network errors are only retried for
+// idempotent requests, as a non-idempotent request may already have been
+// applied. A 429 is always retried because SendGrid rejected the request
+// before processing it. A wait requested by the server through Retry-After
+// or X-RateLimit-Reset is honoured; if it exceeds RetryMaxWait the response
+// is returned without retrying.
+func (c *Client) sendWithRetry(ctx context.Context, restClient *rest.Client, req rest.Request, idempotent bool) (*rest.Response, error) {
+	policy := backoff.NewExponentialBackOff()
+	policy.MaxInterval = c.RetryMaxWait
+	policy.MaxElapsedTime = 0
+	policy.Reset()
+
+	for attempt := 0; ; attempt++ {
+		resp, err := restClient.SendWithContext(ctx, req)
+		if attempt >= c.MaxRetries || !shouldRetry(ctx, idempotent, resp, err) {
+			return resp, err
+		}
+
+		wait := policy.NextBackOff()
+		if d, ok := retryAfter(resp, time.Now()); ok {
+			// Retrying before the server-requested time only yields another
+			// 429, so give up instead when it is further away than allowed.
+			if d > c.RetryMaxWait {
+				log.Printf("[DEBUG] sendgrid: %s %s returned http %d, server requested a wait of %s which exceeds %s, not retrying", req.Method, req.BaseURL, resp.StatusCode, d, c.RetryMaxWait)
+				return resp, err
+			}
+			wait = d
+		}
+		if wait > c.RetryMaxWait {
+			wait = c.RetryMaxWait
+		}
+
+		if err != nil {
+			log.Printf("[DEBUG] sendgrid: %s %s failed: %s, retrying in %s (%d/%d)", req.Method, req.BaseURL, err, wait, attempt+1, c.MaxRetries)
+		} else {
+			log.Printf("[DEBUG] sendgrid: %s %s returned http %d, retrying in %s (%d/%d)", req.Method, req.BaseURL, resp.StatusCode, wait, attempt+1, c.MaxRetries)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(ctx context.Context, idempotent bool, resp *rest.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return idempotent
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent
+	}
+
+	return false
+}
+
+func isIdempotent(method rest.Method) bool {
+	switch method {
+	case rest.Get, rest.Put, rest.Delete, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait time requested by the server, either through
+// Retry-After (seconds or HTTP date) or X-RateLimit-Reset (unix timestamp).
+func retryAfter(resp *rest.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	headers := http.Header(resp.Headers)
+
+	if value := headers.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return nonNegative(date.Sub(now)), true
+		}
+	}
+
+	if value := headers.Get("X-RateLimit-Reset"); value != "" {
+		if reset, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return nonNegative(time.Unix(reset, 0).Sub(now)), true
+		}
+	}
+
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
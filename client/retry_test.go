@@ -0,0 +1,154 @@
+package sendgrid
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sendgrid/rest"
+)
+
+func sequenceTransport(t *testing.T, statuses ...int) (http.RoundTripper, *int) {
+	calls := 0
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if calls >= len(statuses) {
+			t.Fatalf("unexpected request #%d to %s", calls+1, req.URL)
+		}
+		status := statuses[calls]
+		calls++
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Retry-After": []string{"0"}},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	}), &calls
+}
+
+func TestClientRetriesIdempotentRequests(t *testing.T) {
+	transport, calls := sequenceTransport(t, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK)
+
+	c, _ := NewClient("SG.test", WithTransport(transport), WithRetry(3, 10*time.Millisecond))
+	if _, _, err := c.Get(context.Background(), "GET", "/subusers/test"); err != nil {
+		t.Fatalf("Get: %s", err)
+	}
+	if *calls != 3 {
+		t.Errorf("expected 3 calls, got %d", *calls)
+	}
+}
+
+func TestClientDoesNotRetryPostOnServerError(t *testing.T) {
+	transport, calls := sequenceTransport(t, http.StatusInternalServerError)
+
+	c, _ := NewClient("SG.test", WithTransport(transport), WithRetry(3, 10*time.Millisecond))
+	if _, _, err := c.Post(context.Background(), "POST", "/subusers", Subuser{Username: "test"}); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if *calls != 1 {
+		t.Errorf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestClientRetriesSearchOnServerError(t *testing.T) {
+	transport, calls := sequenceTransport(t, http.StatusInternalServerError, http.StatusOK)
+
+	c, _ := NewClient("SG.test", WithTransport(transport), WithRetry(3, 10*time.Millisecond))
+	if _, _, err := c.search(context.Background(), "/asm/groups/1/suppressions/search", recipientEmails{RecipientEmails: []string{"a@example.com"}}); err != nil {
+		t.Fatalf("search: %s", err)
+	}
+	if *calls != 2 {
+		t.Errorf("expected 2 calls, got %d", *calls)
+	}
+}
+
+func TestClientRetriesPostOnRateLimit(t *testing.T) {
+	transport, calls := sequenceTransport(t, http.StatusTooManyRequests, http.StatusCreated)
+
+	c, _ := NewClient("SG.test", WithTransport(transport), WithRetry(3, 10*time.Millisecond))
+	if _, _, err := c.Post(context.Background(), "POST", "/subusers", Subuser{Username: "test"}); err != nil {
+		t.Fatalf("Post: %s", err)
+	}
+	if *calls != 2 {
+		t.Errorf("expected 2 calls, got %d", *calls)
+	}
+}
+
+func TestClientDoesNotRetryClientErrors(t *testing.T) {
+	transport, calls := sequenceTransport(t, http.StatusBadRequest)
+
+	c, _ := NewClient("SG.test", WithTransport(transport), WithRetry(3, 10*time.Millisecond))
+	if _, _, err := c.Get(context.Background(), "GET", "/subusers/test"); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if *calls != 1 {
+		t.Errorf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestClientStopsAfterMaxRetries(t *testing.T) {
+	transport, calls := sequenceTransport(t, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+
+	c, _ := NewClient("SG.test", WithTransport(transport), WithRetry(2, 10*time.Millisecond))
+	_, status, err := c.Get(context.Background(), "DELETE", "/subusers/test")
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if status != http.StatusBadGateway {
+		t.Errorf("expected status 502, got %d", status)
+	}
+	if *calls != 3 {
+		t.Errorf("expected 3 calls, got %d", *calls)
+	}
+}
+
+func TestClientDoesNotRetryBeforeRateLimitReset(t *testing.T) {
+	calls := 0
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{"60"}},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	})
+
+	c, _ := NewClient("SG.test", WithTransport(transport), WithRetry(3, 10*time.Millisecond))
+	_, status, err := c.Get(context.Background(), "GET", "/scopes")
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if status != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", status)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		headers map[string][]string
+		want    time.Duration
+		ok      bool
+	}{
+		{name: "none", headers: map[string][]string{}},
+		{name: "seconds", headers: map[string][]string{"Retry-After": {"7"}}, want: 7 * time.Second, ok: true},
+		{name: "http date", headers: map[string][]string{"Retry-After": {now.Add(5 * time.Second).Format(http.TimeFormat)}}, want: 5 * time.Second, ok: true},
+		{name: "rate limit reset", headers: map[string][]string{"X-Ratelimit-Reset": {"1704110412"}}, want: 12 * time.Second, ok: true},
+		{name: "reset in the past", headers: map[string][]string{"X-Ratelimit-Reset": {"1704110000"}}, want: 0, ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := retryAfter(&rest.Response{Headers: tt.headers}, now)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("expected (%s, %t), got (%s, %t)", tt.want, tt.ok, got, ok)
+			}
+		})
+	}
+}
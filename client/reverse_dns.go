@@ -0,0 +1,83 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ReverseDNSUser is a user allowed to send from the IP of a reverse DNS
+// record.
+type ReverseDNSUser struct {
+	Username string `json:"username,omitempty"`
+	UserID   int64  `json:"user_id,omitempty"`
+}
+
+// ReverseDNS (IP whitelabel) points the PTR record of a dedicated IP at
+// Rdns, <subdomain>.<domain>. ARecord is the DNS record to add for it.
+type ReverseDNS struct {
+	ID        int64               `json:"id,omitempty"`
+	IP        string              `json:"ip,omitempty"`
+	Rdns      string              `json:"rdns,omitempty"`
+	Users     []ReverseDNSUser    `json:"users,omitempty"`
+	Subdomain string              `json:"subdomain,omitempty"`
+	Domain    string              `json:"domain,omitempty"`
+	Valid     bool                `json:"valid"`
+	Legacy    bool                `json:"legacy"`
+	ARecord   Domainauthdnsrecord `json:"a_record,omitempty"`
+}
+
+func parseReverseDNS(respBody string) (*ReverseDNS, error) {
+	var body ReverseDNS
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing reverse dns: %w", err)
+	}
+
+	return &body, nil
+}
+
+// CreateReverseDNS sets up reverse DNS for an IP. Without a subdomain
+// SendGrid generates one.
+func (c *Client) CreateReverseDNS(ctx context.Context, rdns ReverseDNS) (*ReverseDNS, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/whitelabel/ips", struct {
+		IP        string `json:"ip"`
+		Domain    string `json:"domain"`
+		Subdomain string `json:"subdomain,omitempty"`
+	}{rdns.IP, rdns.Domain, rdns.Subdomain})
+	if err != nil {
+		return nil, fmt.Errorf("CreateReverseDNS: %w", err)
+	}
+
+	return parseReverseDNS(respBody)
+}
+
+func (c *Client) ReadReverseDNS(ctx context.Context, id int64) (*ReverseDNS, error) {
+	respBody, _, err := c.Get(ctx, "GET", fmt.Sprintf("/whitelabel/ips/%d", id))
+	if err != nil {
+		return nil, fmt.Errorf("ReadReverseDNS: %w", err)
+	}
+
+	return parseReverseDNS(respBody)
+}
+
+func (c *Client) DeleteReverseDNS(ctx context.Context, id int64) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", fmt.Sprintf("/whitelabel/ips/%d", id))
+	if err != nil {
+		return false, fmt.Errorf("DeleteReverseDNS: %w", err)
+	}
+
+	return true, nil
+}
+
+// ValidateReverseDNS asks SendGrid to check the A record and returns the
+// record with the outcome in Valid.
+func (c *Client) ValidateReverseDNS(ctx context.Context, id int64) (*ReverseDNS, error) {
+	_, _, err := c.Post(ctx, "POST", fmt.Sprintf("/whitelabel/ips/%d/validate", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("ValidateReverseDNS: %w", err)
+	}
+
+	return c.ReadReverseDNS(ctx, id)
+}
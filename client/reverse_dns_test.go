@@ -0,0 +1,63 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+func TestReverseDNSLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+	ip := sendgridtest.DedicatedIPs[0]
+
+	created, err := c.CreateReverseDNS(ctx, ReverseDNS{IP: ip, Domain: "example.com", Subdomain: "mail"})
+	if err != nil {
+		t.Fatalf("CreateReverseDNS: %s", err)
+	}
+	if created.ID == 0 || created.Rdns != "mail.example.com" || created.Valid {
+		t.Errorf("unexpected reverse dns: %+v", created)
+	}
+	if created.ARecord.Host != "mail.example.com" || created.ARecord.Type != "a" || created.ARecord.Data != ip {
+		t.Errorf("unexpected a record: %+v", created.ARecord)
+	}
+
+	if _, err := c.CreateReverseDNS(ctx, ReverseDNS{IP: ip, Domain: "example.org"}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for a second record of the IP, got %v", err)
+	}
+	if _, err := c.CreateReverseDNS(ctx, ReverseDNS{IP: "198.51.100.1", Domain: "example.com"}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for an IP outside the account, got %v", err)
+	}
+
+	generated, err := c.CreateReverseDNS(ctx, ReverseDNS{IP: sendgridtest.DedicatedIPs[1], Domain: "example.com"})
+	if err != nil {
+		t.Fatalf("CreateReverseDNS: %s", err)
+	}
+	if generated.Subdomain == "" {
+		t.Errorf("expected a generated subdomain: %+v", generated)
+	}
+
+	validated, err := c.ValidateReverseDNS(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("ValidateReverseDNS: %s", err)
+	}
+	if !validated.Valid || !validated.ARecord.Valid {
+		t.Errorf("expected the record to be valid: %+v", validated)
+	}
+
+	dedicated, err := c.ReadIP(ctx, ip)
+	if err != nil {
+		t.Fatalf("ReadIP: %s", err)
+	}
+	if !dedicated.Whitelabeled || dedicated.Rdns != "mail.example.com" {
+		t.Errorf("expected the IP to have reverse dns: %+v", dedicated)
+	}
+
+	if _, err := c.DeleteReverseDNS(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteReverseDNS: %s", err)
+	}
+	if _, err := c.ReadReverseDNS(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
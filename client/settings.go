@@ -0,0 +1,40 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// readSetting reads an account level setting, like a mail or a tracking
+// setting. name prefixes errors.
+func readSetting[T any](ctx context.Context, c *Client, name, endpoint string) (*T, error) {
+	respBody, _, err := c.Get(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return parseSetting[T](name, respBody)
+}
+
+// updateSetting updates an account level setting, settings always exist and
+// are never created nor deleted.
+func updateSetting[T any](ctx context.Context, c *Client, name, endpoint string, setting T) (*T, error) {
+	respBody, _, err := c.Post(ctx, "PATCH", endpoint, setting)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return parseSetting[T](name, respBody)
+}
+
+func parseSetting[T any](name, respBody string) (*T, error) {
+	var body T
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed parsing setting: %w", name, err)
+	}
+
+	return &body, nil
+}
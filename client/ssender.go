@@ -48,7 +48,7 @@ func (c *Client) CreateSingleSender(ctx context.Context, receiveditems Singlesen
 
 	respBody, _, err := c.Post(ctx, "POST", "/verified_senders", receiveditems)
 	if err != nil {
-		return nil, fmt.Errorf("CreateSingleSender: Bad Request:" + err.Error())
+		return nil, fmt.Errorf("CreateSingleSender: Bad Request: %w", err)
 	}
 
 	var response ReturnSinglesender
@@ -60,29 +60,77 @@ func (c *Client) CreateSingleSender(ctx context.Context, receiveditems Singlesen
 	return &response, nil
 }
 
-// SinglesenderRead reads a singlesender.
-func (c *Client) ReadSingleSender(ctx context.Context, id string) (*ReturnSinglesender, error) {
+// SenderDomainWarnList lists the domains known to implement DMARC. Senders
+// from HardFailures domains are rejected, senders from SoftFailures domains
+// may have their emails blocked by the receiving server.
+type SenderDomainWarnList struct {
+	HardFailures []string `json:"hard_failures"`
+	SoftFailures []string `json:"soft_failures"`
+}
+
+func singleSenderPages(c *Client) *paginator[ReturnSinglesender] {
+	pages := newPaginator(c, "/verified_senders", func(respBody string) ([]ReturnSinglesender, error) {
+		var response SinglesenderResult
+		err := json.Unmarshal([]byte(respBody), &response)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing singlesender: %w", err)
+		}
+		return response.Result, nil
+	})
+	// verified senders are paged by the id of the last sender seen
+	pages.cursor = func(last ReturnSinglesender) (string, string) {
+		return "lastSeenID", fmt.Sprintf("%d", last.ID)
+	}
+
+	return pages
+}
 
-	var response SinglesenderResult
-	var convertedsinglesender ReturnSinglesender
+// ListSingleSenders returns every sender of the account.
+func (c *Client) ListSingleSenders(ctx context.Context) ([]ReturnSinglesender, error) {
 
-	respBody, _, err := c.Get(ctx, "GET", "/verified_senders")
+	items, err := singleSenderPages(c).all(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("ReadSingleSender: Bad Request:" + err.Error())
+		return nil, fmt.Errorf("ListSingleSenders: %w", err)
 	}
 
+	return items, nil
+}
+
+// ReadSenderDomainWarnList returns the domains senders should not or
+// cannot be created for.
+func (c *Client) ReadSenderDomainWarnList(ctx context.Context) (*SenderDomainWarnList, error) {
+
+	respBody, _, err := c.Get(ctx, "GET", "/verified_senders/domains")
+	if err != nil {
+		return nil, fmt.Errorf("ReadSenderDomainWarnList: %w", err)
+	}
+
+	var response struct {
+		Results SenderDomainWarnList `json:"results"`
+	}
 	err = json.Unmarshal([]byte(respBody), &response)
 	if err != nil {
-		return nil, fmt.Errorf("ReadSingleSender: failed parsing singlesender: %w", err)
+		return nil, fmt.Errorf("ReadSenderDomainWarnList: failed parsing sender domains: %w", err)
 	}
 
-	for _, item := range response.Result {
-		if id == fmt.Sprintf("%d", item.ID) {
-			convertedsinglesender = item
-		}
+	return &response.Results, nil
+}
+
+// SinglesenderRead reads a singlesender.
+func (c *Client) ReadSingleSender(ctx context.Context, id string) (*ReturnSinglesender, error) {
+
+	item, found, err := singleSenderPages(c).find(ctx, func(item ReturnSinglesender) bool {
+		return id == fmt.Sprintf("%d", item.ID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ReadSingleSender: Bad Request: %w", err)
 	}
 
-	return &convertedsinglesender, nil
+	if found {
+		return &item, nil
+	}
+
+	return nil, fmt.Errorf("ReadSingleSender: single sender %s: %w", id, ErrNotFound)
 }
 
 // Singlesenderdelete updates a singlesender.
@@ -90,7 +138,7 @@ func (c *Client) DeleteSingleSender(ctx context.Context, id string) (bool, error
 
 	respBody, statusCode, err := c.Get(ctx, "DELETE", "/verified_senders/"+id)
 	if err != nil {
-		return false, fmt.Errorf("DeleteSingleSender: Bad Request:" + err.Error())
+		return false, fmt.Errorf("DeleteSingleSender: Bad Request: %w", err)
 	}
 
 	if respBody == "" && statusCode == 204 {
@@ -105,7 +153,7 @@ func (c *Client) UpdateSingleSender(ctx context.Context, updateitem Singlesender
 	tmpitem := fmt.Sprintf("%d", updateitem.ID)
 	updaterespBody, _, err := c.Post(ctx, "PATCH", "/verified_senders/"+tmpitem, updateitem)
 	if err != nil {
-		return nil, fmt.Errorf("UpdateSingleSender: Bad Request:" + err.Error())
+		return nil, fmt.Errorf("UpdateSingleSender: Bad Request: %w", err)
 	}
 
 	var updateresponse ReturnSinglesender
@@ -116,3 +164,15 @@ func (c *Client) UpdateSingleSender(ctx context.Context, updateitem Singlesender
 
 	return &updateresponse, nil
 }
+
+// ResendSingleSenderVerification sends the verification email of a sender
+// again.
+func (c *Client) ResendSingleSenderVerification(ctx context.Context, id string) (bool, error) {
+
+	_, _, err := c.Post(ctx, "POST", "/verified_senders/resend/"+id, nil)
+	if err != nil {
+		return false, fmt.Errorf("ResendSingleSenderVerification: %w", err)
+	}
+
+	return true, nil
+}
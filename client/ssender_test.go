@@ -0,0 +1,110 @@
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSingleSenderLifecycle(t *testing.T) {
+	c, srv := newFakeClient(t)
+	ctx := context.Background()
+
+	sender := Singlesender{
+		Nickname:  "support",
+		FromEmail: "support@example.com",
+		FromName:  "Support",
+		ReplyTo:   "support@example.com",
+		Address:   "1 Main Street",
+		City:      "Denver",
+		Country:   "USA",
+	}
+
+	created, err := c.CreateSingleSender(ctx, sender)
+	if err != nil {
+		t.Fatalf("CreateSingleSender: %s", err)
+	}
+	if created.ID == 0 || created.Verified {
+		t.Errorf("unexpected sender: %+v", created)
+	}
+
+	_, err = c.CreateSingleSender(ctx, Singlesender{Nickname: "broken"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Errors[0].Field != "from_email" {
+		t.Errorf("expected field error on from_email, got %v", err)
+	}
+
+	if _, err := c.ResendSingleSenderVerification(ctx, fmt.Sprint(created.ID)); err != nil {
+		t.Errorf("ResendSingleSenderVerification: %s", err)
+	}
+
+	srv.VerifySender("support@example.com")
+
+	if _, err := c.ResendSingleSenderVerification(ctx, fmt.Sprint(created.ID)); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for a verified sender, got %v", err)
+	}
+
+	sender.ID = created.ID
+	sender.City = "Boulder"
+	updated, err := c.UpdateSingleSender(ctx, sender)
+	if err != nil {
+		t.Fatalf("UpdateSingleSender: %s", err)
+	}
+	if updated.City != "Boulder" || !updated.Verified {
+		t.Errorf("unexpected update response: %+v", updated)
+	}
+
+	id := fmt.Sprint(created.ID)
+	read, err := c.ReadSingleSender(ctx, id)
+	if err != nil {
+		t.Fatalf("ReadSingleSender: %s", err)
+	}
+	if read.City != "Boulder" {
+		t.Errorf("unexpected sender: %+v", read)
+	}
+
+	if _, err := c.DeleteSingleSender(ctx, id); err != nil {
+		t.Fatalf("DeleteSingleSender: %s", err)
+	}
+	if _, err := c.ReadSingleSender(ctx, id); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestListSingleSenders(t *testing.T) {
+	c, srv := newFakeClient(t)
+	c.PageSize = 1
+	ctx := context.Background()
+
+	for _, name := range []string{"support", "billing"} {
+		_, err := c.CreateSingleSender(ctx, Singlesender{
+			Nickname:  name,
+			FromEmail: name + "@example.com",
+			ReplyTo:   name + "@example.com",
+			Address:   "1 Main Street",
+			City:      "Denver",
+			Country:   "USA",
+		})
+		if err != nil {
+			t.Fatalf("CreateSingleSender: %s", err)
+		}
+	}
+	srv.VerifySender("billing@example.com")
+
+	senders, err := c.ListSingleSenders(ctx)
+	if err != nil {
+		t.Fatalf("ListSingleSenders: %s", err)
+	}
+	if len(senders) != 2 || senders[0].Nickname != "support" || senders[0].Verified || !senders[1].Verified {
+		t.Errorf("unexpected senders: %+v", senders)
+	}
+
+	warnings, err := c.ReadSenderDomainWarnList(ctx)
+	if err != nil {
+		t.Fatalf("ReadSenderDomainWarnList: %s", err)
+	}
+	if len(warnings.HardFailures) == 0 || len(warnings.SoftFailures) == 0 {
+		t.Errorf("unexpected domain warn list: %+v", warnings)
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
+	"net/url"
 )
 
 type Subuser struct {
@@ -20,14 +20,14 @@ func (c *Client) CreateSubuser(ctx context.Context, subuser Subuser) (*Subuser,
 
 	createRespBody, _, err := c.Post(ctx, "POST", "/subusers", subuser)
 	if err != nil {
-		return nil, fmt.Errorf("CreateSubuser: Failed to Create:" + err.Error())
+		return nil, fmt.Errorf("CreateSubuser: Failed to Create: %w", err)
 	}
 
 	var body Subuser
 
 	err = json.Unmarshal([]byte(createRespBody), &body)
 	if err != nil {
-		return nil, fmt.Errorf("CreateSubuser: Failed to Unmarshal:" + err.Error())
+		return nil, fmt.Errorf("CreateSubuser: Failed to Unmarshal: %w", err)
 	}
 
 	return c.GetSubuser(ctx, body)
@@ -37,14 +37,14 @@ func (c *Client) GetSubuser(ctx context.Context, userdata Subuser) (*Subuser, er
 
 	getRespBody, _, err := c.Get(ctx, "GET", "/subusers/"+userdata.Username)
 	if err != nil {
-		return nil, fmt.Errorf("GetSubuser: Failed to Get userdata:" + err.Error())
+		return nil, fmt.Errorf("GetSubuser: Failed to Get userdata: %w", err)
 	}
 
 	var body Subuser
 
 	err = json.Unmarshal([]byte(getRespBody), &body)
 	if err != nil {
-		return nil, fmt.Errorf("GetSubuser: Failed to Unmarshal:" + err.Error())
+		return nil, fmt.Errorf("GetSubuser: Failed to Unmarshal: %w", err)
 	}
 
 	return &body, nil
@@ -52,12 +52,12 @@ func (c *Client) GetSubuser(ctx context.Context, userdata Subuser) (*Subuser, er
 
 func (c *Client) UpdateSubuser(ctx context.Context, userdata Subuser) (*Subuser, error) {
 
-	_, statusCode, err := c.Post(ctx, "PATCH", "/subusers/"+userdata.Username, Subuser{
+	_, _, err := c.Post(ctx, "PATCH", "/subusers/"+userdata.Username, Subuser{
 		Disabled: userdata.Disabled,
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("failed updating subUser: " + err.Error() + ". StatusCode: " + strconv.Itoa(statusCode))
+		return nil, fmt.Errorf("failed updating subUser: %w", err)
 	}
 
 	return c.GetSubuser(ctx, userdata)
@@ -65,40 +65,73 @@ func (c *Client) UpdateSubuser(ctx context.Context, userdata Subuser) (*Subuser,
 
 func (c *Client) DeleteSubuser(ctx context.Context, userdata string) (bool, error) {
 
-	_, statusCode, err := c.Get(ctx, "DELETE", "/subusers/"+userdata)
+	_, _, err := c.Get(ctx, "DELETE", "/subusers/"+userdata)
 
 	if err != nil {
-		return false, fmt.Errorf("failed deleting subUser: " + err.Error() + ". StatusCode: " + strconv.Itoa(statusCode))
+		return false, fmt.Errorf("failed deleting subUser: %w", err)
 	}
 
 	return true, nil
 }
 
+// UpdateIp replaces the IPs of a subuser, see SetSubuserIPs.
 func (c *Client) UpdateIp(ctx context.Context, uip Subuser) (*Subuser, error) {
 
-	_, statusCode, err := c.Post(ctx, "PATCH", "/subusers/"+uip.Username, Subuser{
-		Ips: uip.Ips,
-	})
-
+	_, err := c.SetSubuserIPs(ctx, uip.Username, uip.Ips)
 	if err != nil {
-		return nil, fmt.Errorf("failed updating subUser IP: " + err.Error() + ". StatusCode: " + strconv.Itoa(statusCode))
+		return nil, fmt.Errorf("failed updating subUser IP: %w", err)
 	}
 
 	return c.GetSubuser(ctx, uip)
 }
 
+// SetSubuserIPs replaces the IPs a subuser can send from. The IPs must be
+// assigned to the parent account and at least one is required.
+func (c *Client) SetSubuserIPs(ctx context.Context, username string, ips []string) ([]string, error) {
+	respBody, _, err := c.Post(ctx, "PUT", "/subusers/"+username+"/ips", ips)
+	if err != nil {
+		return nil, fmt.Errorf("SetSubuserIPs: %w", err)
+	}
+
+	var body struct {
+		Ips []string `json:"ips"`
+	}
+	err = json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("SetSubuserIPs: failed parsing ips: %w", err)
+	}
+
+	return body.Ips, nil
+}
+
+// ReadSubuserIPs returns the IPs a subuser can send from, listed by the IPs
+// endpoint filtered on the subuser.
+func (c *Client) ReadSubuserIPs(ctx context.Context, username string) ([]string, error) {
+	ips, err := newPaginator(c, withQuery("/ips", url.Values{"subuser": {username}}), parseDedicatedIPs).all(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ReadSubuserIPs: %w", err)
+	}
+
+	addresses := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, ip.IP)
+	}
+
+	return addresses, nil
+}
+
 func (c *Client) ReadSubuser(ctx context.Context, userdata string) (*Subuser, error) {
 
 	getRespBody, _, err := c.Get(ctx, "GET", "/subusers/"+userdata)
 	if err != nil {
-		return nil, fmt.Errorf("GetSubuser: Failed to Get userdata:" + err.Error())
+		return nil, fmt.Errorf("GetSubuser: Failed to Get userdata: %w", err)
 	}
 
 	var body Subuser
 
 	err = json.Unmarshal([]byte(getRespBody), &body)
 	if err != nil {
-		return nil, fmt.Errorf("GetSubuser: Failed to Unmarshal:" + err.Error())
+		return nil, fmt.Errorf("GetSubuser: Failed to Unmarshal: %w", err)
 	}
 
 	return &body, nil
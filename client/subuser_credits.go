@@ -0,0 +1,82 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+const (
+	// SubuserCreditsUnlimited lets the subuser send as much as the plan of
+	// the parent account allows.
+	SubuserCreditsUnlimited = "unlimited"
+	// SubuserCreditsRecurring resets the remaining credits of the subuser
+	// to Total at every ResetFrequency, daily, weekly or monthly.
+	SubuserCreditsRecurring = "recurring"
+	// SubuserCreditsNonrecurring gives the subuser Total credits once.
+	SubuserCreditsNonrecurring = "nonrecurring"
+)
+
+// SubuserCredits limits the number of emails a subuser can send. Total and
+// ResetFrequency are only used by limited credit types.
+type SubuserCredits struct {
+	Type           string `json:"type"`
+	ResetFrequency string `json:"reset_frequency,omitempty"`
+	Total          int64  `json:"total"`
+	Remain         int64  `json:"remain"`
+	Used           int64  `json:"used"`
+}
+
+func parseSubuserCredits(respBody string) (*SubuserCredits, error) {
+	var body SubuserCredits
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing subuser credits: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (c *Client) ReadSubuserCredits(ctx context.Context, username string) (*SubuserCredits, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/subusers/"+url.PathEscape(username)+"/credits")
+	if err != nil {
+		return nil, fmt.Errorf("ReadSubuserCredits: %w", err)
+	}
+
+	return parseSubuserCredits(respBody)
+}
+
+// SetSubuserCredits replaces the credit limit of a subuser. Total is only
+// sent for limited credit types.
+func (c *Client) SetSubuserCredits(ctx context.Context, username string, credits SubuserCredits) (*SubuserCredits, error) {
+	body := struct {
+		Type           string `json:"type"`
+		ResetFrequency string `json:"reset_frequency,omitempty"`
+		Total          *int64 `json:"total,omitempty"`
+	}{Type: credits.Type, ResetFrequency: credits.ResetFrequency}
+	if credits.Type != SubuserCreditsUnlimited {
+		body.Total = &credits.Total
+	}
+
+	respBody, _, err := c.Post(ctx, "PUT", "/subusers/"+url.PathEscape(username)+"/credits", body)
+	if err != nil {
+		return nil, fmt.Errorf("SetSubuserCredits: %w", err)
+	}
+
+	return parseSubuserCredits(respBody)
+}
+
+// AdjustSubuserRemainingCredits adds amount to the remaining credits of a
+// subuser, a negative amount removes credits.
+func (c *Client) AdjustSubuserRemainingCredits(ctx context.Context, username string, amount int64) (*SubuserCredits, error) {
+	respBody, _, err := c.Post(ctx, "PATCH", "/subusers/"+url.PathEscape(username)+"/remaining_credits", struct {
+		AllocationUpdate int64 `json:"allocation_update"`
+	}{amount})
+	if err != nil {
+		return nil, fmt.Errorf("AdjustSubuserRemainingCredits: %w", err)
+	}
+
+	return parseSubuserCredits(respBody)
+}
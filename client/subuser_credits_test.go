@@ -0,0 +1,62 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSubuserCredits(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	if _, err := c.ReadSubuserCredits(ctx, "nobody"); !IsNotFound(err) {
+		t.Errorf("expected not found for an unknown subuser, got %v", err)
+	}
+
+	if _, err := c.CreateSubuser(ctx, Subuser{Username: "credits", Email: "credits@example.com", Password: "s3cret!pass"}); err != nil {
+		t.Fatalf("CreateSubuser: %s", err)
+	}
+
+	credits, err := c.ReadSubuserCredits(ctx, "credits")
+	if err != nil {
+		t.Fatalf("ReadSubuserCredits: %s", err)
+	}
+	if credits.Type != SubuserCreditsUnlimited {
+		t.Errorf("expected unlimited credits for a new subuser, got %+v", credits)
+	}
+
+	if _, err := c.AdjustSubuserRemainingCredits(ctx, "credits", 10); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 adjusting unlimited credits, got %v", err)
+	}
+
+	credits, err = c.SetSubuserCredits(ctx, "credits", SubuserCredits{Type: SubuserCreditsRecurring, ResetFrequency: "monthly", Total: 1000})
+	if err != nil {
+		t.Fatalf("SetSubuserCredits: %s", err)
+	}
+	if credits.Total != 1000 || credits.Remain != 1000 || credits.ResetFrequency != "monthly" {
+		t.Errorf("unexpected credits: %+v", credits)
+	}
+
+	credits, err = c.AdjustSubuserRemainingCredits(ctx, "credits", -250)
+	if err != nil {
+		t.Fatalf("AdjustSubuserRemainingCredits: %s", err)
+	}
+	if credits.Remain != 750 {
+		t.Errorf("expected 750 remaining credits, got %+v", credits)
+	}
+
+	if _, err := c.AdjustSubuserRemainingCredits(ctx, "credits", -1000); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for negative remaining credits, got %v", err)
+	}
+
+	if _, err := c.SetSubuserCredits(ctx, "credits", SubuserCredits{Type: SubuserCreditsUnlimited}); err != nil {
+		t.Fatalf("SetSubuserCredits: %s", err)
+	}
+	credits, err = c.ReadSubuserCredits(ctx, "credits")
+	if err != nil {
+		t.Fatalf("ReadSubuserCredits: %s", err)
+	}
+	if credits.Type != SubuserCreditsUnlimited || credits.Total != 0 {
+		t.Errorf("unexpected credits: %+v", credits)
+	}
+}
@@ -0,0 +1,94 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-sendgrid/internal/sendgridtest"
+)
+
+func TestSubuserLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	created, err := c.CreateSubuser(ctx, Subuser{
+		Username: "sub1",
+		Email:    "sub1@example.com",
+		Password: "C3|zh!%SR],jgD5d",
+		Ips:      []string{"192.0.2.1"},
+	})
+	if err != nil {
+		t.Fatalf("CreateSubuser: %s", err)
+	}
+	if created.ID == 0 || created.Email != "sub1@example.com" {
+		t.Errorf("unexpected subuser: %+v", created)
+	}
+
+	_, err = c.CreateSubuser(ctx, Subuser{Username: "sub1", Email: "sub1@example.com", Password: "x"})
+	if StatusCodeOf(err) != 400 {
+		t.Errorf("expected duplicate username to be rejected, got %v", err)
+	}
+
+	updated, err := c.UpdateSubuser(ctx, Subuser{Username: "sub1", Disabled: true})
+	if err != nil {
+		t.Fatalf("UpdateSubuser: %s", err)
+	}
+	if !updated.Disabled {
+		t.Errorf("expected subuser to be disabled: %+v", updated)
+	}
+
+	updated, err = c.UpdateIp(ctx, Subuser{Username: "sub1", Ips: []string{"192.0.2.2"}})
+	if err != nil {
+		t.Fatalf("UpdateIp: %s", err)
+	}
+	if len(updated.Ips) != 1 || updated.Ips[0] != "192.0.2.2" {
+		t.Errorf("unexpected ips: %v", updated.Ips)
+	}
+
+	if _, err := c.DeleteSubuser(ctx, "sub1"); err != nil {
+		t.Fatalf("DeleteSubuser: %s", err)
+	}
+	if _, err := c.ReadSubuser(ctx, "sub1"); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestSubuserIPs(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	_, err := c.CreateSubuser(ctx, Subuser{Username: "sub1", Email: "sub1@example.com", Password: "C3|zh!%SR],jgD5d", Ips: []string{sendgridtest.DedicatedIPs[0]}})
+	if err != nil {
+		t.Fatalf("CreateSubuser: %s", err)
+	}
+
+	want := []string{sendgridtest.DedicatedIPs[1], sendgridtest.DedicatedIPs[2]}
+	set, err := c.SetSubuserIPs(ctx, "sub1", want)
+	if err != nil {
+		t.Fatalf("SetSubuserIPs: %s", err)
+	}
+	if len(set) != 2 {
+		t.Errorf("unexpected ips: %v", set)
+	}
+
+	// the last IP of the fake is not assigned to the account
+	unassigned := sendgridtest.DedicatedIPs[len(sendgridtest.DedicatedIPs)-1]
+	if _, err := c.SetSubuserIPs(ctx, "sub1", []string{unassigned}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for an unassigned IP, got %v", err)
+	}
+	if _, err := c.SetSubuserIPs(ctx, "sub1", []string{}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for no IPs, got %v", err)
+	}
+
+	read, err := c.ReadSubuserIPs(ctx, "sub1")
+	if err != nil {
+		t.Fatalf("ReadSubuserIPs: %s", err)
+	}
+	if len(read) != 2 || read[0] != want[0] || read[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, read)
+	}
+
+	if _, err := c.SetSubuserIPs(ctx, "missing", want); !IsNotFound(err) {
+		t.Errorf("expected not found for a missing subuser, got %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type recipientEmails struct {
+	RecipientEmails []string `json:"recipient_emails"`
+}
+
+func parseRecipientEmails(respBody string) ([]string, error) {
+	var body recipientEmails
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing recipient emails: %w", err)
+	}
+
+	return body.RecipientEmails, nil
+}
+
+// AddGlobalSuppressions suppresses emails for every email sent by the
+// account. Emails that are already suppressed are ignored.
+func (c *Client) AddGlobalSuppressions(ctx context.Context, emails []string) ([]string, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/asm/suppressions/global", recipientEmails{RecipientEmails: emails})
+	if err != nil {
+		return nil, fmt.Errorf("AddGlobalSuppressions: %w", err)
+	}
+
+	return parseRecipientEmails(respBody)
+}
+
+// IsGloballySuppressed reports whether email is on the global suppression
+// list. SendGrid answers with an empty object instead of a 404 for emails
+// that are not suppressed.
+func (c *Client) IsGloballySuppressed(ctx context.Context, email string) (bool, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/asm/suppressions/global/"+url.PathEscape(email))
+	if err != nil {
+		if IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("IsGloballySuppressed: %w", err)
+	}
+
+	var body struct {
+		RecipientEmail string `json:"recipient_email"`
+	}
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return false, fmt.Errorf("failed parsing global suppression: %w", err)
+	}
+
+	return body.RecipientEmail != "", nil
+}
+
+func (c *Client) DeleteGlobalSuppression(ctx context.Context, email string) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", "/asm/suppressions/global/"+url.PathEscape(email))
+	if err != nil {
+		return false, fmt.Errorf("DeleteGlobalSuppression: %w", err)
+	}
+
+	return true, nil
+}
+
+// AddGroupSuppressions unsubscribes emails from an unsubscribe group.
+func (c *Client) AddGroupSuppressions(ctx context.Context, groupID int64, emails []string) ([]string, error) {
+	respBody, _, err := c.Post(ctx, "POST", fmt.Sprintf("/asm/groups/%d/suppressions", groupID), recipientEmails{RecipientEmails: emails})
+	if err != nil {
+		return nil, fmt.Errorf("AddGroupSuppressions: %w", err)
+	}
+
+	return parseRecipientEmails(respBody)
+}
+
+// ListGroupSuppressions returns every email unsubscribed from a group.
+func (c *Client) ListGroupSuppressions(ctx context.Context, groupID int64) ([]string, error) {
+	respBody, _, err := c.Get(ctx, "GET", fmt.Sprintf("/asm/groups/%d/suppressions", groupID))
+	if err != nil {
+		return nil, fmt.Errorf("ListGroupSuppressions: %w", err)
+	}
+
+	var emails []string
+	if err := json.Unmarshal([]byte(respBody), &emails); err != nil {
+		return nil, fmt.Errorf("failed parsing group suppressions: %w", err)
+	}
+
+	return emails, nil
+}
+
+// SearchGroupSuppressions returns the subset of emails that are unsubscribed
+// from a group, without listing the whole group.
+func (c *Client) SearchGroupSuppressions(ctx context.Context, groupID int64, emails []string) ([]string, error) {
+	respBody, _, err := c.search(ctx, fmt.Sprintf("/asm/groups/%d/suppressions/search", groupID), recipientEmails{RecipientEmails: emails})
+	if err != nil {
+		return nil, fmt.Errorf("SearchGroupSuppressions: %w", err)
+	}
+
+	var found []string
+	if err := json.Unmarshal([]byte(respBody), &found); err != nil {
+		return nil, fmt.Errorf("failed parsing group suppressions: %w", err)
+	}
+
+	return found, nil
+}
+
+func (c *Client) DeleteGroupSuppression(ctx context.Context, groupID int64, email string) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", fmt.Sprintf("/asm/groups/%d/suppressions/%s", groupID, url.PathEscape(email)))
+	if err != nil {
+		return false, fmt.Errorf("DeleteGroupSuppression: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,75 @@
+package sendgrid
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestGlobalSuppressionLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	added, err := c.AddGlobalSuppressions(ctx, []string{"a@example.com", "b+tag@example.com"})
+	if err != nil {
+		t.Fatalf("AddGlobalSuppressions: %s", err)
+	}
+	if len(added) != 2 {
+		t.Errorf("unexpected emails: %v", added)
+	}
+
+	suppressed, err := c.IsGloballySuppressed(ctx, "b+tag@example.com")
+	if err != nil || !suppressed {
+		t.Errorf("IsGloballySuppressed: %t, %v", suppressed, err)
+	}
+
+	if _, err := c.DeleteGlobalSuppression(ctx, "b+tag@example.com"); err != nil {
+		t.Fatalf("DeleteGlobalSuppression: %s", err)
+	}
+	suppressed, err = c.IsGloballySuppressed(ctx, "b+tag@example.com")
+	if err != nil || suppressed {
+		t.Errorf("expected email to be removed: %t, %v", suppressed, err)
+	}
+}
+
+func TestGroupSuppressionLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	group, err := c.CreateUnsubscribeGroup(ctx, UnsubscribeGroup{Name: "newsletter"})
+	if err != nil {
+		t.Fatalf("CreateUnsubscribeGroup: %s", err)
+	}
+
+	if _, err := c.AddGroupSuppressions(ctx, group.ID, []string{"a@example.com", "b@example.com"}); err != nil {
+		t.Fatalf("AddGroupSuppressions: %s", err)
+	}
+
+	found, err := c.SearchGroupSuppressions(ctx, group.ID, []string{"b@example.com", "c@example.com"})
+	if err != nil {
+		t.Fatalf("SearchGroupSuppressions: %s", err)
+	}
+	if !reflect.DeepEqual(found, []string{"b@example.com"}) {
+		t.Errorf("unexpected search result: %v", found)
+	}
+
+	if _, err := c.DeleteGroupSuppression(ctx, group.ID, "a@example.com"); err != nil {
+		t.Fatalf("DeleteGroupSuppression: %s", err)
+	}
+
+	emails, err := c.ListGroupSuppressions(ctx, group.ID)
+	if err != nil {
+		t.Fatalf("ListGroupSuppressions: %s", err)
+	}
+	if !reflect.DeepEqual(emails, []string{"b@example.com"}) {
+		t.Errorf("unexpected suppressions: %v", emails)
+	}
+
+	group, err = c.ReadUnsubscribeGroup(ctx, group.ID)
+	if err != nil {
+		t.Fatalf("ReadUnsubscribeGroup: %s", err)
+	}
+	if group.Unsubscribes != 1 {
+		t.Errorf("expected 1 unsubscribe, got %+v", group)
+	}
+}
@@ -0,0 +1,157 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	TemplateGenerationDynamic = "dynamic"
+	TemplateGenerationLegacy  = "legacy"
+)
+
+// Template is a transactional template. Its content lives in versions.
+type Template struct {
+	ID         string            `json:"id,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	Generation string            `json:"generation,omitempty"`
+	UpdatedAt  string            `json:"updated_at,omitempty"`
+	Versions   []TemplateVersion `json:"versions,omitempty"`
+}
+
+// TemplateVersion is one version of a transactional template. Only one
+// version of a template is active at a time.
+type TemplateVersion struct {
+	ID                   string `json:"id,omitempty"`
+	TemplateID           string `json:"template_id,omitempty"`
+	Name                 string `json:"name,omitempty"`
+	Subject              string `json:"subject,omitempty"`
+	HTMLContent          string `json:"html_content,omitempty"`
+	PlainContent         string `json:"plain_content,omitempty"`
+	GeneratePlainContent bool   `json:"generate_plain_content"`
+	Active               int    `json:"active,omitempty"`
+	Editor               string `json:"editor,omitempty"`
+	TestData             string `json:"test_data,omitempty"`
+	UpdatedAt            string `json:"updated_at,omitempty"`
+}
+
+func parseTemplate(respBody string) (*Template, error) {
+	var body Template
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing template: %w", err)
+	}
+
+	return &body, nil
+}
+
+func parseTemplateVersion(respBody string) (*TemplateVersion, error) {
+	var body TemplateVersion
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing template version: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (c *Client) CreateTemplate(ctx context.Context, template Template) (*Template, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/templates", Template{
+		Name:       template.Name,
+		Generation: template.Generation,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CreateTemplate: %w", err)
+	}
+
+	return parseTemplate(respBody)
+}
+
+func (c *Client) ReadTemplate(ctx context.Context, templateID string) (*Template, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/templates/"+templateID)
+	if err != nil {
+		return nil, fmt.Errorf("ReadTemplate: %w", err)
+	}
+
+	return parseTemplate(respBody)
+}
+
+// UpdateTemplate renames a template, the generation cannot be changed.
+func (c *Client) UpdateTemplate(ctx context.Context, template Template) (*Template, error) {
+	respBody, _, err := c.Post(ctx, "PATCH", "/templates/"+template.ID, Template{
+		Name: template.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UpdateTemplate: %w", err)
+	}
+
+	return parseTemplate(respBody)
+}
+
+func (c *Client) DeleteTemplate(ctx context.Context, templateID string) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", "/templates/"+templateID)
+	if err != nil {
+		return false, fmt.Errorf("DeleteTemplate: %w", err)
+	}
+
+	return true, nil
+}
+
+func (c *Client) CreateTemplateVersion(ctx context.Context, version TemplateVersion) (*TemplateVersion, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/templates/"+version.TemplateID+"/versions", version)
+	if err != nil {
+		return nil, fmt.Errorf("CreateTemplateVersion: %w", err)
+	}
+
+	return parseTemplateVersion(respBody)
+}
+
+func (c *Client) ReadTemplateVersion(ctx context.Context, templateID, versionID string) (*TemplateVersion, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/templates/"+templateID+"/versions/"+versionID)
+	if err != nil {
+		return nil, fmt.Errorf("ReadTemplateVersion: %w", err)
+	}
+
+	return parseTemplateVersion(respBody)
+}
+
+func (c *Client) UpdateTemplateVersion(ctx context.Context, version TemplateVersion) (*TemplateVersion, error) {
+	respBody, _, err := c.Post(ctx, "PATCH", "/templates/"+version.TemplateID+"/versions/"+version.ID, TemplateVersion{
+		Name:                 version.Name,
+		Subject:              version.Subject,
+		HTMLContent:          version.HTMLContent,
+		PlainContent:         version.PlainContent,
+		GeneratePlainContent: version.GeneratePlainContent,
+		Active:               version.Active,
+		Editor:               version.Editor,
+		TestData:             version.TestData,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UpdateTemplateVersion: %w", err)
+	}
+
+	return parseTemplateVersion(respBody)
+}
+
+// ActivateTemplateVersion makes a version the active one of its template,
+// deactivating the version that was active before.
+func (c *Client) ActivateTemplateVersion(ctx context.Context, templateID, versionID string) (*TemplateVersion, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/templates/"+templateID+"/versions/"+versionID+"/activate", nil)
+	if err != nil {
+		return nil, fmt.Errorf("ActivateTemplateVersion: %w", err)
+	}
+
+	return parseTemplateVersion(respBody)
+}
+
+func (c *Client) DeleteTemplateVersion(ctx context.Context, templateID, versionID string) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", "/templates/"+templateID+"/versions/"+versionID)
+	if err != nil {
+		return false, fmt.Errorf("DeleteTemplateVersion: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,91 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTemplateLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	template, err := c.CreateTemplate(ctx, Template{Name: "welcome", Generation: TemplateGenerationDynamic})
+	if err != nil {
+		t.Fatalf("CreateTemplate: %s", err)
+	}
+	if template.ID == "" || template.Generation != TemplateGenerationDynamic {
+		t.Errorf("unexpected template: %+v", template)
+	}
+
+	if _, err := c.UpdateTemplate(ctx, Template{ID: template.ID, Name: "welcome-v2"}); err != nil {
+		t.Fatalf("UpdateTemplate: %s", err)
+	}
+
+	first, err := c.CreateTemplateVersion(ctx, TemplateVersion{
+		TemplateID:           template.ID,
+		Name:                 "v1",
+		Subject:              "Welcome {{name}}",
+		HTMLContent:          "<p>Hello {{name}}</p>",
+		GeneratePlainContent: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateTemplateVersion: %s", err)
+	}
+	if first.Active != 1 || first.PlainContent != "Hello {{name}}" {
+		t.Errorf("unexpected first version: %+v", first)
+	}
+
+	second, err := c.CreateTemplateVersion(ctx, TemplateVersion{
+		TemplateID: template.ID,
+		Name:       "v2",
+		Subject:    "Welcome",
+		Editor:     "design",
+	})
+	if err != nil {
+		t.Fatalf("CreateTemplateVersion: %s", err)
+	}
+	if second.Active != 0 {
+		t.Errorf("expected second version to be inactive: %+v", second)
+	}
+
+	if _, err := c.ActivateTemplateVersion(ctx, template.ID, second.ID); err != nil {
+		t.Fatalf("ActivateTemplateVersion: %s", err)
+	}
+	first, err = c.ReadTemplateVersion(ctx, template.ID, first.ID)
+	if err != nil {
+		t.Fatalf("ReadTemplateVersion: %s", err)
+	}
+	if first.Active != 0 {
+		t.Errorf("expected first version to be deactivated: %+v", first)
+	}
+
+	updated, err := c.UpdateTemplateVersion(ctx, TemplateVersion{ID: first.ID, TemplateID: template.ID, Name: "v1", Subject: "Hi", Active: 1})
+	if err != nil {
+		t.Fatalf("UpdateTemplateVersion: %s", err)
+	}
+	if updated.Subject != "Hi" || updated.Active != 1 {
+		t.Errorf("unexpected update response: %+v", updated)
+	}
+
+	read, err := c.ReadTemplate(ctx, template.ID)
+	if err != nil {
+		t.Fatalf("ReadTemplate: %s", err)
+	}
+	if read.Name != "welcome-v2" || len(read.Versions) != 2 {
+		t.Errorf("unexpected template: %+v", read)
+	}
+
+	if _, err := c.DeleteTemplateVersion(ctx, template.ID, second.ID); err != nil {
+		t.Fatalf("DeleteTemplateVersion: %s", err)
+	}
+	if _, err := c.ReadTemplateVersion(ctx, template.ID, second.ID); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+
+	if _, err := c.DeleteTemplate(ctx, template.ID); err != nil {
+		t.Fatalf("DeleteTemplate: %s", err)
+	}
+	if _, err := c.ReadTemplate(ctx, template.ID); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
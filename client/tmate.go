@@ -56,24 +56,38 @@ func parseUser(respBody string) (*User, error) {
 	return &body, nil
 }
 
-func (c *Client) GetUsernameByEmail(ctx context.Context, email string) (string, error) {
-	respBody, _, err := c.Get(ctx, "GET", "/teammates?limit=10000")
+func decodeUsers(respBody string) ([]User, error) {
+	users := &Users{}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(respBody)))
+	err := decoder.Decode(users)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	users := &Users{}
+	return users.Result, nil
+}
 
-	decoder := json.NewDecoder(bytes.NewReader([]byte(respBody)))
-	err = decoder.Decode(users)
+// teammates pages through /teammates.
+func (c *Client) teammates() *paginator[User] {
+	return newPaginator(c, "/teammates", decodeUsers)
+}
+
+// pendingTeammates pages through /teammates/pending.
+func (c *Client) pendingTeammates() *paginator[User] {
+	return newPaginator(c, "/teammates/pending", decodeUsers)
+}
+
+func (c *Client) GetUsernameByEmail(ctx context.Context, email string) (string, error) {
+	user, found, err := c.teammates().find(ctx, func(user User) bool {
+		return user.Email == email && user.Username != ""
+	})
 	if err != nil {
 		return "", err
 	}
 
-	for _, user := range users.Result {
-		if user.Email == email && user.Username != "" {
-			return user.Username, nil
-		}
+	if found {
+		return user.Username, nil
 	}
 
 	//return "", fmt.Errorf("username with email %s not found", email)
@@ -104,7 +118,7 @@ func (c *Client) CreateTeammate(ctx context.Context, user User) (*User, error) {
 	//if err != nil {
 	respBody, _, err := c.Post(ctx, "POST", "/teammates", user)
 	if err != nil {
-		return nil, fmt.Errorf("CreateUser: Bad Request:" + err.Error())
+		return nil, fmt.Errorf("CreateUser: Bad Request: %w", err)
 	}
 
 	var body User
@@ -114,22 +128,15 @@ func (c *Client) CreateTeammate(ctx context.Context, user User) (*User, error) {
 		return nil, fmt.Errorf("CreateTeammate: failed parsing teammate: %w", err)
 	}
 
-	getcompletedetails, _, err := c.Get(ctx, "GET", "/teammates/pending")
+	pu, found, err := c.pendingTeammates().find(ctx, func(pu User) bool {
+		return pu.Email == user.Email
+	})
 	if err != nil {
 		return nil, fmt.Errorf("CreateTeammate: unable to retrive invited user details: %w", err)
 	}
 
-	pendingUsers := &Users{}
-	decoder1 := json.NewDecoder(bytes.NewReader([]byte(getcompletedetails)))
-	err = decoder1.Decode(pendingUsers)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, pu := range pendingUsers.Result {
-		if pu.Email == user.Email {
-			return &pu, nil
-		}
+	if found {
+		return &pu, nil
 	}
 
 	//	return parseUser(respBody)
@@ -140,57 +147,39 @@ func (c *Client) CreateTeammate(ctx context.Context, user User) (*User, error) {
 
 func (c *Client) RefreshTeammate(ctx context.Context, email string) (*User, error) {
 
-	respBody, _, err := c.Get(ctx, "GET", "/teammates?limit=10000")
-	if err != nil {
-		return nil, err
-	}
-
-	users := &Users{}
-
-	decoder := json.NewDecoder(bytes.NewReader([]byte(respBody)))
-	err = decoder.Decode(users)
+	user, found, err := c.teammates().find(ctx, func(user User) bool {
+		return user.Email == email && user.Username != ""
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, user := range users.Result {
-		if user.Email == email && user.Username != "" {
-			respBody, _, err := c.Get(ctx, "GET", "/teammates/"+user.Username)
-			if err != nil {
-				return nil, err
-			}
-
-			var u User
-			err = json.Unmarshal([]byte(respBody), &u)
-			if err != nil {
-				return nil, err
-			}
-			return &u, nil
+	if found {
+		respBody, _, err := c.Get(ctx, "GET", "/teammates/"+user.Username)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	respBody1, _, err := c.Get(ctx, "GET", "/teammates/pending")
-	if err != nil {
-		return nil, err
+		var u User
+		err = json.Unmarshal([]byte(respBody), &u)
+		if err != nil {
+			return nil, err
+		}
+		return &u, nil
 	}
 
-	pendingUsers := &Users{}
-	decoder1 := json.NewDecoder(bytes.NewReader([]byte(respBody1)))
-	err = decoder1.Decode(pendingUsers)
+	pu, found, err := c.pendingTeammates().find(ctx, func(pu User) bool {
+		return pu.Email == email
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, pu := range pendingUsers.Result {
-		if pu.Email == email {
-			//golan build struct
-			//var p User
-			//return nil, fmt.Errorf("pending user with email not found:%+v", &pu)
-			return &pu, nil
-		}
+	if found {
+		return &pu, nil
 	}
 
-	return nil, fmt.Errorf("username with email %s not found", email)
+	return nil, fmt.Errorf("teammate with email %s: %w", email, ErrNotFound)
 }
 
 // func (c *Client) GetPendingUser(ctx context.Context, email string) (string, error) {
@@ -221,7 +210,7 @@ func (c *Client) UpdateTeammate(ctx context.Context, updateitems User) (*User, e
 		return nil, fmt.Errorf("User with email %s ", updateitems.Email+" not found, Please accept the invite first")
 	}
 
-	respBody1, _, err := c.Post(ctx, "PATCH", "/teammates/"+updateitems.Username, User{
+	respBody1, _, err := c.Post(ctx, "PATCH", "/teammates/"+username.Username, User{
 		IsAdmin: updateitems.IsAdmin,
 		Scopes:  updateitems.Scopes,
 	})
@@ -249,13 +238,13 @@ func (c *Client) DeleteTeammate(ctx context.Context, email string) (bool, error)
 	if getusername.Username != "" && getusername.Token == "" {
 		_, _, err := c.Get(ctx, "DELETE", "/teammates/"+getusername.Username)
 		if err != nil {
-			return false, fmt.Errorf("DeleteTeammate: Failed to Delete:" + err.Error())
+			return false, fmt.Errorf("DeleteTeammate: Failed to Delete: %w", err)
 		}
 		return true, nil
 	} else {
 		_, _, err := c.Get(ctx, "DELETE", "/teammates/pending/"+getusername.Token)
 		if err != nil {
-			return false, fmt.Errorf("DeleteTeammate: Failed to Delete:" + err.Error())
+			return false, fmt.Errorf("DeleteTeammate: Failed to Delete: %w", err)
 		}
 		return true, nil
 	}
@@ -0,0 +1,99 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestTeammateLifecycle(t *testing.T) {
+	c, srv := newFakeClient(t)
+	ctx := context.Background()
+
+	invited, err := c.CreateTeammate(ctx, User{Email: "jane@example.com", Scopes: []string{"mail.send"}})
+	if err != nil {
+		t.Fatalf("CreateTeammate: %s", err)
+	}
+	if invited.Token == "" {
+		t.Fatalf("expected a pending invite, got %+v", invited)
+	}
+
+	pending, err := c.RefreshTeammate(ctx, "jane@example.com")
+	if err != nil {
+		t.Fatalf("RefreshTeammate: %s", err)
+	}
+	if pending.Token != invited.Token || pending.Username != "" {
+		t.Errorf("expected pending teammate, got %+v", pending)
+	}
+
+	username, err := srv.AcceptInvite("jane@example.com")
+	if err != nil {
+		t.Fatalf("AcceptInvite: %s", err)
+	}
+
+	active, err := c.RefreshTeammate(ctx, "jane@example.com")
+	if err != nil {
+		t.Fatalf("RefreshTeammate: %s", err)
+	}
+	if active.Username != username || active.Token != "" || len(active.Scopes) != 1 {
+		t.Errorf("expected active teammate, got %+v", active)
+	}
+
+	updated, err := c.UpdateTeammate(ctx, User{Email: "jane@example.com", Username: username, IsAdmin: true})
+	if err != nil {
+		t.Fatalf("UpdateTeammate: %s", err)
+	}
+	if !updated.IsAdmin {
+		t.Errorf("expected admin teammate, got %+v", updated)
+	}
+
+	if _, err := c.DeleteTeammate(ctx, "jane@example.com"); err != nil {
+		t.Fatalf("DeleteTeammate: %s", err)
+	}
+	if _, err := c.RefreshTeammate(ctx, "jane@example.com"); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestDeletePendingTeammate(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	if _, err := c.CreateTeammate(ctx, User{Email: "joe@example.com", IsAdmin: true}); err != nil {
+		t.Fatalf("CreateTeammate: %s", err)
+	}
+	if _, err := c.DeleteTeammate(ctx, "joe@example.com"); err != nil {
+		t.Fatalf("DeleteTeammate: %s", err)
+	}
+	if _, err := c.RefreshTeammate(ctx, "joe@example.com"); !IsNotFound(err) {
+		t.Errorf("expected invite to be revoked, got %v", err)
+	}
+}
+
+func TestPendingTeammatesArePaged(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+	c.PageSize = 2
+
+	var last *User
+	for i := 0; i < 5; i++ {
+		invited, err := c.CreateTeammate(ctx, User{Email: fmt.Sprintf("invite%d@example.com", i), IsAdmin: true})
+		if err != nil {
+			t.Fatalf("CreateTeammate: %s", err)
+		}
+		last = invited
+	}
+
+	requested := recordRequests(c)
+	pending, err := c.RefreshTeammate(ctx, "invite4@example.com")
+	if err != nil {
+		t.Fatalf("RefreshTeammate: %s", err)
+	}
+	if pending.Token == "" || pending.Token != last.Token {
+		t.Errorf("expected the last invite, got %+v", pending)
+	}
+	// one page of active teammates, then three pages of invites
+	if len(*requested) != 4 {
+		t.Errorf("expected 4 pages, got %v", *requested)
+	}
+}
@@ -0,0 +1,70 @@
+package sendgrid
+
+import (
+	"context"
+)
+
+// TrackingSettingClick rewrites the links of emails to track clicks.
+type TrackingSettingClick struct {
+	Enabled    bool `json:"enabled"`
+	EnableText bool `json:"enable_text"`
+}
+
+// TrackingSettingOpen adds an invisible image to emails to track opens.
+type TrackingSettingOpen struct {
+	Enabled bool `json:"enabled"`
+}
+
+// TrackingSettingSubscription adds an unsubscribe link to every email. The
+// contents must contain the <% %> tag, replaced by the link, and Replace is
+// a tag that is replaced by the unsubscribe URL.
+type TrackingSettingSubscription struct {
+	Enabled      bool   `json:"enabled"`
+	HTMLContent  string `json:"html_content"`
+	PlainContent string `json:"plain_content"`
+	Landing      string `json:"landing"`
+	Replace      string `json:"replace"`
+	URL          string `json:"url"`
+}
+
+// TrackingSettingGoogleAnalytics adds UTM parameters to the links of emails.
+type TrackingSettingGoogleAnalytics struct {
+	Enabled     bool   `json:"enabled"`
+	UTMSource   string `json:"utm_source"`
+	UTMMedium   string `json:"utm_medium"`
+	UTMTerm     string `json:"utm_term"`
+	UTMContent  string `json:"utm_content"`
+	UTMCampaign string `json:"utm_campaign"`
+}
+
+func (c *Client) ReadTrackingSettingClick(ctx context.Context) (*TrackingSettingClick, error) {
+	return readSetting[TrackingSettingClick](ctx, c, "ReadTrackingSettingClick", "/tracking_settings/click")
+}
+
+func (c *Client) UpdateTrackingSettingClick(ctx context.Context, setting TrackingSettingClick) (*TrackingSettingClick, error) {
+	return updateSetting(ctx, c, "UpdateTrackingSettingClick", "/tracking_settings/click", setting)
+}
+
+func (c *Client) ReadTrackingSettingOpen(ctx context.Context) (*TrackingSettingOpen, error) {
+	return readSetting[TrackingSettingOpen](ctx, c, "ReadTrackingSettingOpen", "/tracking_settings/open")
+}
+
+func (c *Client) UpdateTrackingSettingOpen(ctx context.Context, setting TrackingSettingOpen) (*TrackingSettingOpen, error) {
+	return updateSetting(ctx, c, "UpdateTrackingSettingOpen", "/tracking_settings/open", setting)
+}
+
+func (c *Client) ReadTrackingSettingSubscription(ctx context.Context) (*TrackingSettingSubscription, error) {
+	return readSetting[TrackingSettingSubscription](ctx, c, "ReadTrackingSettingSubscription", "/tracking_settings/subscription")
+}
+
+func (c *Client) UpdateTrackingSettingSubscription(ctx context.Context, setting TrackingSettingSubscription) (*TrackingSettingSubscription, error) {
+	return updateSetting(ctx, c, "UpdateTrackingSettingSubscription", "/tracking_settings/subscription", setting)
+}
+
+func (c *Client) ReadTrackingSettingGoogleAnalytics(ctx context.Context) (*TrackingSettingGoogleAnalytics, error) {
+	return readSetting[TrackingSettingGoogleAnalytics](ctx, c, "ReadTrackingSettingGoogleAnalytics", "/tracking_settings/google_analytics")
+}
+
+func (c *Client) UpdateTrackingSettingGoogleAnalytics(ctx context.Context, setting TrackingSettingGoogleAnalytics) (*TrackingSettingGoogleAnalytics, error) {
+	return updateSetting(ctx, c, "UpdateTrackingSettingGoogleAnalytics", "/tracking_settings/google_analytics", setting)
+}
@@ -0,0 +1,45 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTrackingSettings(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	click, err := c.UpdateTrackingSettingClick(ctx, TrackingSettingClick{Enabled: true, EnableText: true})
+	if err != nil {
+		t.Fatalf("UpdateTrackingSettingClick: %s", err)
+	}
+	if !click.Enabled || !click.EnableText {
+		t.Errorf("unexpected click tracking: %+v", click)
+	}
+
+	open, err := c.ReadTrackingSettingOpen(ctx)
+	if err != nil {
+		t.Fatalf("ReadTrackingSettingOpen: %s", err)
+	}
+	if open.Enabled {
+		t.Errorf("expected open tracking to be disabled: %+v", open)
+	}
+
+	if _, err := c.UpdateTrackingSettingSubscription(ctx, TrackingSettingSubscription{Enabled: true, HTMLContent: "<p>Unsubscribe</p>"}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 without the <%% %%> tag, got %v", err)
+	}
+	if _, err := c.UpdateTrackingSettingSubscription(ctx, TrackingSettingSubscription{Enabled: true, HTMLContent: "<p><% %></p>", Replace: "[unsubscribe]"}); err != nil {
+		t.Fatalf("UpdateTrackingSettingSubscription: %s", err)
+	}
+
+	if _, err := c.UpdateTrackingSettingGoogleAnalytics(ctx, TrackingSettingGoogleAnalytics{Enabled: true, UTMSource: "sendgrid", UTMMedium: "email"}); err != nil {
+		t.Fatalf("UpdateTrackingSettingGoogleAnalytics: %s", err)
+	}
+	analytics, err := c.ReadTrackingSettingGoogleAnalytics(ctx)
+	if err != nil {
+		t.Fatalf("ReadTrackingSettingGoogleAnalytics: %s", err)
+	}
+	if analytics.UTMMedium != "email" || analytics.UTMCampaign != "" {
+		t.Errorf("unexpected google analytics: %+v", analytics)
+	}
+}
@@ -0,0 +1,98 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// UnsubscribeGroup is a suppression group (ASM group) recipients can
+// unsubscribe from without opting out of every email.
+type UnsubscribeGroup struct {
+	ID           int64  `json:"id,omitempty"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	IsDefault    bool   `json:"is_default"`
+	Unsubscribes int64  `json:"unsubscribes,omitempty"`
+}
+
+func parseUnsubscribeGroup(respBody string) (*UnsubscribeGroup, error) {
+	var body UnsubscribeGroup
+
+	err := json.Unmarshal([]byte(respBody), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing unsubscribe group: %w", err)
+	}
+
+	return &body, nil
+}
+
+func (c *Client) CreateUnsubscribeGroup(ctx context.Context, group UnsubscribeGroup) (*UnsubscribeGroup, error) {
+	respBody, _, err := c.Post(ctx, "POST", "/asm/groups", UnsubscribeGroup{
+		Name:        group.Name,
+		Description: group.Description,
+		IsDefault:   group.IsDefault,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CreateUnsubscribeGroup: %w", err)
+	}
+
+	return parseUnsubscribeGroup(respBody)
+}
+
+func (c *Client) ReadUnsubscribeGroup(ctx context.Context, groupID int64) (*UnsubscribeGroup, error) {
+	respBody, _, err := c.Get(ctx, "GET", fmt.Sprintf("/asm/groups/%d", groupID))
+	if err != nil {
+		return nil, fmt.Errorf("ReadUnsubscribeGroup: %w", err)
+	}
+
+	return parseUnsubscribeGroup(respBody)
+}
+
+// FindUnsubscribeGroup looks up a group by its name. Group names are unique
+// within an account. The list endpoint is not paged, an account has at most
+// a few hundred groups.
+func (c *Client) FindUnsubscribeGroup(ctx context.Context, name string) (*UnsubscribeGroup, error) {
+	respBody, _, err := c.Get(ctx, "GET", "/asm/groups")
+	if err != nil {
+		return nil, fmt.Errorf("FindUnsubscribeGroup: %w", err)
+	}
+
+	var groups []UnsubscribeGroup
+	if err := json.Unmarshal([]byte(respBody), &groups); err != nil {
+		return nil, fmt.Errorf("failed parsing unsubscribe groups: %w", err)
+	}
+
+	for i := range groups {
+		if groups[i].Name == name {
+			return &groups[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsubscribe group %q: %w", name, ErrNotFound)
+}
+
+// UpdateUnsubscribeGroup updates the name, description and default flag of
+// a group. Making a group the default one clears the flag on the previous
+// default group.
+func (c *Client) UpdateUnsubscribeGroup(ctx context.Context, group UnsubscribeGroup) (*UnsubscribeGroup, error) {
+	respBody, _, err := c.Post(ctx, "PATCH", fmt.Sprintf("/asm/groups/%d", group.ID), UnsubscribeGroup{
+		Name:        group.Name,
+		Description: group.Description,
+		IsDefault:   group.IsDefault,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UpdateUnsubscribeGroup: %w", err)
+	}
+
+	return parseUnsubscribeGroup(respBody)
+}
+
+func (c *Client) DeleteUnsubscribeGroup(ctx context.Context, groupID int64) (bool, error) {
+	_, _, err := c.Get(ctx, "DELETE", fmt.Sprintf("/asm/groups/%d", groupID))
+	if err != nil {
+		return false, fmt.Errorf("DeleteUnsubscribeGroup: %w", err)
+	}
+
+	return true, nil
+}
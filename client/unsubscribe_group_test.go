@@ -0,0 +1,61 @@
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestUnsubscribeGroupLifecycle(t *testing.T) {
+	c, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	newsletter, err := c.CreateUnsubscribeGroup(ctx, UnsubscribeGroup{Name: "newsletter", Description: "Weekly newsletter", IsDefault: true})
+	if err != nil {
+		t.Fatalf("CreateUnsubscribeGroup: %s", err)
+	}
+	if newsletter.ID == 0 || !newsletter.IsDefault {
+		t.Errorf("unexpected group: %+v", newsletter)
+	}
+
+	if _, err := c.CreateUnsubscribeGroup(ctx, UnsubscribeGroup{Name: "newsletter"}); StatusCodeOf(err) != 400 {
+		t.Errorf("expected a 400 for a duplicate name, got %v", err)
+	}
+
+	alerts, err := c.CreateUnsubscribeGroup(ctx, UnsubscribeGroup{Name: "alerts", Description: "Account alerts"})
+	if err != nil {
+		t.Fatalf("CreateUnsubscribeGroup: %s", err)
+	}
+
+	found, err := c.FindUnsubscribeGroup(ctx, "alerts")
+	if err != nil {
+		t.Fatalf("FindUnsubscribeGroup: %s", err)
+	}
+	if found.ID != alerts.ID {
+		t.Errorf("expected group %d, got %+v", alerts.ID, found)
+	}
+	if _, err := c.FindUnsubscribeGroup(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	alerts.Description = "Security alerts"
+	alerts.IsDefault = true
+	if _, err := c.UpdateUnsubscribeGroup(ctx, *alerts); err != nil {
+		t.Fatalf("UpdateUnsubscribeGroup: %s", err)
+	}
+
+	newsletter, err = c.ReadUnsubscribeGroup(ctx, newsletter.ID)
+	if err != nil {
+		t.Fatalf("ReadUnsubscribeGroup: %s", err)
+	}
+	if newsletter.IsDefault {
+		t.Errorf("expected the previous default group to be cleared: %+v", newsletter)
+	}
+
+	if _, err := c.DeleteUnsubscribeGroup(ctx, alerts.ID); err != nil {
+		t.Fatalf("DeleteUnsubscribeGroup: %s", err)
+	}
+	if _, err := c.ReadUnsubscribeGroup(ctx, alerts.ID); !IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
@@ -1,3 +1,12 @@
 data "sendgrid_domain_authentication" "name" {
   id = 123456789
-}
\ No newline at end of file
+}
+# look up a domain authenticated elsewhere by its name
+data "sendgrid_domain_authentication" "by_name" {
+  domain    = "example.com"
+  subdomain = "em" # optional
+}
+
+output "dkim1" {
+  value = data.sendgrid_domain_authentication.by_name.dkim1
+}
@@ -0,0 +1,7 @@
+data "sendgrid_ips" "assigned" {
+  assigned_only = true
+}
+
+output "unpooled_ips" {
+  value = [for ip in data.sendgrid_ips.assigned.ips : ip.ip if length(ip.pools) == 0]
+}
@@ -0,0 +1,3 @@
+data "sendgrid_unsubscribe_group" "newsletter" {
+  name = "newsletter"
+}
@@ -0,0 +1,7 @@
+data "sendgrid_verified_senders" "example" {
+  domain = "example.com"
+}
+
+output "unverified_senders" {
+  value = [for sender in data.sendgrid_verified_senders.example.senders : sender.from_email if !sender.verified]
+}
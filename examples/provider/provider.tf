@@ -2,3 +2,10 @@
 provider "sendgrid" {
   apikey = "SG.*************************************"
 }
+
+# EU data residency
+provider "sendgrid" {
+  alias  = "eu"
+  apikey = "SG.*************************************"
+  region = "eu"
+}
@@ -0,0 +1,11 @@
+resource "sendgrid_alert" "usage" {
+  type       = "usage_limit"
+  email_to   = "oncall@example.com"
+  percentage = 90
+}
+
+resource "sendgrid_alert" "stats" {
+  type      = "stats_notification"
+  email_to  = "stats@example.com"
+  frequency = "weekly"
+}
@@ -0,0 +1,2 @@
+terraform import sendgrid_alert.example 12345 # Replace with your alert ID
+terraform import sendgrid_alert.example "subuser1,12345" # alert of the subuser "subuser1"
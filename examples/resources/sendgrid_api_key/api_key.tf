@@ -5,4 +5,13 @@ resource "sendgrid_api_key" "name" {
     "alerts.create",
     "alerts.read"
   ]
-}
\ No newline at end of file
+}
+
+# API key created inside a subuser account
+resource "sendgrid_api_key" "subuser" {
+  name         = "subuser-mail-send"
+  on_behalf_of = "subuser1"
+  scopes = [
+    "mail.send"
+  ]
+}
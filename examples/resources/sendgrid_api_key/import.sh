@@ -1 +1,2 @@
-terraform import sendgrid_api_key.example 1234567890 # Replace 1234567890 with your API key ID
\ No newline at end of file
+terraform import sendgrid_api_key.example 1234567890 # Replace 1234567890 with your API key ID
+terraform import sendgrid_api_key.example "subuser1,1234567890" # API key of the subuser "subuser1"
\ No newline at end of file
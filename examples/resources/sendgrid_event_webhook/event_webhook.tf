@@ -0,0 +1,14 @@
+resource "sendgrid_event_webhook" "events" {
+  url       = "https://hooks.example.com/sendgrid/events"
+  delivered = true
+  bounce    = true
+  dropped   = true
+  open      = true
+  click     = true
+  signed    = true
+}
+
+# The key the receiving service verifies signatures with
+output "event_webhook_public_key" {
+  value = sendgrid_event_webhook.events.public_key
+}
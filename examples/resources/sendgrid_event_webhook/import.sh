@@ -0,0 +1,2 @@
+terraform import sendgrid_event_webhook.example event_webhook
+terraform import sendgrid_event_webhook.example "subuser1,event_webhook" # event webhook of the subuser "subuser1"
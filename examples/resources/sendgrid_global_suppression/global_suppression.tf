@@ -0,0 +1,6 @@
+resource "sendgrid_global_suppression" "compliance" {
+  emails = [
+    "legal-hold@example.com",
+    "do-not-contact@example.com",
+  ]
+}
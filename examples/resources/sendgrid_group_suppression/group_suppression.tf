@@ -0,0 +1,10 @@
+resource "sendgrid_unsubscribe_group" "newsletter" {
+  name = "newsletter"
+}
+
+resource "sendgrid_group_suppression" "newsletter" {
+  group_id = sendgrid_unsubscribe_group.newsletter.id
+  emails = [
+    "opted-out@example.com",
+  ]
+}
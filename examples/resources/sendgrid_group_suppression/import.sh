@@ -0,0 +1,2 @@
+terraform import sendgrid_group_suppression.example 12345 # Replace with your group ID, every email of the group is imported
+terraform import sendgrid_group_suppression.example "subuser1,12345" # group of the subuser "subuser1"
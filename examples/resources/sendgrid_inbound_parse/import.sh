@@ -0,0 +1,2 @@
+terraform import sendgrid_inbound_parse.example support.example.com # Replace with your hostname
+terraform import sendgrid_inbound_parse.example "subuser1,support.example.com" # setting of the subuser "subuser1"
@@ -0,0 +1,6 @@
+resource "sendgrid_inbound_parse" "support" {
+  hostname   = "support.example.com" # example.com must be an authenticated domain
+  url        = "https://support.example.com/inbound"
+  spam_check = true
+  send_raw   = false
+}
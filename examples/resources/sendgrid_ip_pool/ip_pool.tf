@@ -0,0 +1,3 @@
+resource "sendgrid_ip_pool" "marketing" {
+  name = "marketing"
+}
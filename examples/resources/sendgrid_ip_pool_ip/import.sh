@@ -0,0 +1 @@
+terraform import sendgrid_ip_pool_ip.example "marketing,192.0.2.1"
@@ -0,0 +1,10 @@
+resource "sendgrid_ip_pool" "marketing" {
+  name = "marketing"
+}
+
+resource "sendgrid_ip_pool_ip" "marketing" {
+  for_each = toset(["192.0.2.1", "192.0.2.2"])
+
+  pool_name = sendgrid_ip_pool.marketing.name
+  ip        = each.key
+}
@@ -0,0 +1,3 @@
+resource "sendgrid_ip_warmup" "new" {
+  ip = "192.0.2.1"
+}
@@ -1 +1,2 @@
-terraform import sendgrid_ipwhitelist.example "xxx.xx.xxx.xx/32" # Replace xxx.xx.xxx.xx/32 with your IP address
\ No newline at end of file
+terraform import sendgrid_ipwhitelist.example "xxx.xx.xxx.xx/32" # Replace xxx.xx.xxx.xx/32 with your IP address
+terraform import sendgrid_ipwhitelist.example "subuser1,xxx.xx.xxx.xx/32" # IP address whitelisted for the subuser "subuser1"
\ No newline at end of file
@@ -1 +1,2 @@
-terraform import sendgrid_linkbrand.example "1234567890" # Replace 1234567890 with your domain ID
\ No newline at end of file
+terraform import sendgrid_linkbrand.example "1234567890" # Replace 1234567890 with your domain ID
+terraform import sendgrid_linkbrand.example "subuser1,1234567890" # Link branding of the subuser "subuser1"
\ No newline at end of file
@@ -0,0 +1,2 @@
+terraform import sendgrid_mail_settings_address_whitelist.example mail_settings_address_whitelist
+terraform import sendgrid_mail_settings_address_whitelist.example "subuser1,mail_settings_address_whitelist" # setting of the subuser "subuser1"
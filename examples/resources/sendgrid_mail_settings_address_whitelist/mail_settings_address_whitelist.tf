@@ -0,0 +1,6 @@
+resource "sendgrid_mail_settings_address_whitelist" "allowed" {
+  list = [
+    "example.com",
+    "ceo@example.org",
+  ]
+}
@@ -0,0 +1,2 @@
+terraform import sendgrid_mail_settings_bcc.example mail_settings_bcc
+terraform import sendgrid_mail_settings_bcc.example "subuser1,mail_settings_bcc" # setting of the subuser "subuser1"
@@ -0,0 +1,3 @@
+resource "sendgrid_mail_settings_bcc" "archive" {
+  email = "archive@example.com"
+}
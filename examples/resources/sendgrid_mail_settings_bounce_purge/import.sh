@@ -0,0 +1,2 @@
+terraform import sendgrid_mail_settings_bounce_purge.example mail_settings_bounce_purge
+terraform import sendgrid_mail_settings_bounce_purge.example "subuser1,mail_settings_bounce_purge" # setting of the subuser "subuser1"
@@ -0,0 +1,4 @@
+resource "sendgrid_mail_settings_bounce_purge" "purge" {
+  soft_bounces = 7
+  hard_bounces = 90
+}
@@ -0,0 +1,2 @@
+terraform import sendgrid_mail_settings_footer.example mail_settings_footer
+terraform import sendgrid_mail_settings_footer.example "subuser1,mail_settings_footer" # setting of the subuser "subuser1"
@@ -0,0 +1,4 @@
+resource "sendgrid_mail_settings_footer" "legal" {
+  html_content  = "<p>Example Inc., 1 Main Street, Denver</p>"
+  plain_content = "Example Inc., 1 Main Street, Denver"
+}
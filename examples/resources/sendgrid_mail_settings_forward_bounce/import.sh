@@ -0,0 +1,2 @@
+terraform import sendgrid_mail_settings_forward_bounce.example mail_settings_forward_bounce
+terraform import sendgrid_mail_settings_forward_bounce.example "subuser1,mail_settings_forward_bounce" # setting of the subuser "subuser1"
@@ -0,0 +1,3 @@
+resource "sendgrid_mail_settings_forward_bounce" "bounces" {
+  email = "bounces@example.com"
+}
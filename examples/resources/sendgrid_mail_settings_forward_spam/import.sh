@@ -0,0 +1,2 @@
+terraform import sendgrid_mail_settings_forward_spam.example mail_settings_forward_spam
+terraform import sendgrid_mail_settings_forward_spam.example "subuser1,mail_settings_forward_spam" # setting of the subuser "subuser1"
@@ -0,0 +1,3 @@
+resource "sendgrid_mail_settings_forward_spam" "abuse" {
+  email = "abuse@example.com,postmaster@example.com"
+}
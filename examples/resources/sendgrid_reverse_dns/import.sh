@@ -0,0 +1 @@
+terraform import sendgrid_reverse_dns.example 123456
@@ -0,0 +1,5 @@
+resource "sendgrid_reverse_dns" "new" {
+  ip        = "192.0.2.1"
+  domain    = "example.com"
+  subdomain = "mail"
+}
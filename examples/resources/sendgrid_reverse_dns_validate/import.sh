@@ -0,0 +1 @@
+terraform import sendgrid_reverse_dns_validate.example 123456
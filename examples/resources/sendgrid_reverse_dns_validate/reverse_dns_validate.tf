@@ -0,0 +1,3 @@
+resource "sendgrid_reverse_dns_validate" "new" {
+  id = sendgrid_reverse_dns.new.id
+}
@@ -1 +1,2 @@
-terraform import sendgrid_single_sender.example  12345678 # Replace 12345678 with your single sender ID
\ No newline at end of file
+terraform import sendgrid_single_sender.example  12345678 # Replace 12345678 with your single sender ID
+terraform import sendgrid_single_sender.example "subuser1,12345678" # Single sender of the subuser "subuser1"
\ No newline at end of file
@@ -11,4 +11,8 @@ resource "sendgrid_single_sender" "name" {
   state = "CA"
   zip = "95369"
   country = "US"
+
+  # block the apply until the link in the verification email is clicked
+  wait_for_verification = true
+  verification_timeout = 900
 }
\ No newline at end of file
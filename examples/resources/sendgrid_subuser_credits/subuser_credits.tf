@@ -0,0 +1,9 @@
+resource "sendgrid_subuser_credits" "marketing" {
+  username        = "marketing"
+  type            = "recurring"
+  total           = 100000
+  reset_frequency = "monthly"
+
+  # every change adds the whole new value to the remaining credits
+  adjust_remaining = 5000
+}
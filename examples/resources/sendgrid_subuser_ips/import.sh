@@ -0,0 +1 @@
+terraform import sendgrid_subuser_ips.example marketing
@@ -0,0 +1,4 @@
+resource "sendgrid_subuser_ips" "marketing" {
+  username = "marketing"
+  ips      = ["192.0.2.1", "192.0.2.2"]
+}
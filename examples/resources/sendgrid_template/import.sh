@@ -0,0 +1,2 @@
+terraform import sendgrid_template.example d-1234567890abcdef1234567890abcdef # Replace with your template ID
+terraform import sendgrid_template.example "subuser1,d-1234567890abcdef1234567890abcdef" # template of the subuser "subuser1"
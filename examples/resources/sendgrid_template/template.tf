@@ -0,0 +1,4 @@
+resource "sendgrid_template" "welcome" {
+  name       = "welcome"
+  generation = "dynamic" # "dynamic" or "legacy"
+}
@@ -0,0 +1,2 @@
+terraform import sendgrid_template_version.example "d-1234567890abcdef1234567890abcdef/8aefe0ee-f12b-4575-b5b7-c97e21cb36f3" # template_id/version_id
+terraform import sendgrid_template_version.example "subuser1,d-1234567890abcdef1234567890abcdef/8aefe0ee-f12b-4575-b5b7-c97e21cb36f3" # version of the subuser "subuser1"
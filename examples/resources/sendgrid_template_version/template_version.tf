@@ -0,0 +1,15 @@
+resource "sendgrid_template" "welcome" {
+  name = "welcome"
+}
+
+resource "sendgrid_template_version" "welcome_v1" {
+  template_id  = sendgrid_template.welcome.id
+  name         = "welcome-v1"
+  subject      = "Welcome {{first_name}}"
+  html_content = file("${path.module}/welcome.html")
+  active       = true
+  editor       = "code" # "code" or "design"
+  test_data = jsonencode({
+    first_name = "Jane"
+  })
+}
@@ -0,0 +1,2 @@
+terraform import sendgrid_tracking_settings_click.example tracking_settings_click
+terraform import sendgrid_tracking_settings_click.example "subuser1,tracking_settings_click" # setting of the subuser "subuser1"
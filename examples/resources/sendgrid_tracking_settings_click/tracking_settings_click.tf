@@ -0,0 +1,3 @@
+resource "sendgrid_tracking_settings_click" "example" {
+  enable_text = true
+}
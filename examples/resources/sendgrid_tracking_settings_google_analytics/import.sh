@@ -0,0 +1,2 @@
+terraform import sendgrid_tracking_settings_google_analytics.example tracking_settings_google_analytics
+terraform import sendgrid_tracking_settings_google_analytics.example "subuser1,tracking_settings_google_analytics" # setting of the subuser "subuser1"
@@ -0,0 +1,5 @@
+resource "sendgrid_tracking_settings_google_analytics" "example" {
+  utm_source   = "sendgrid"
+  utm_medium   = "email"
+  utm_campaign = "newsletter"
+}
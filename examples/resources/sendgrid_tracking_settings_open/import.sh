@@ -0,0 +1,2 @@
+terraform import sendgrid_tracking_settings_open.example tracking_settings_open
+terraform import sendgrid_tracking_settings_open.example "subuser1,tracking_settings_open" # setting of the subuser "subuser1"
@@ -0,0 +1,2 @@
+terraform import sendgrid_tracking_settings_subscription.example tracking_settings_subscription
+terraform import sendgrid_tracking_settings_subscription.example "subuser1,tracking_settings_subscription" # setting of the subuser "subuser1"
@@ -0,0 +1,5 @@
+resource "sendgrid_tracking_settings_subscription" "example" {
+  html_content  = "<p><a href=\"<% %>\">Unsubscribe</a></p>"
+  plain_content = "Unsubscribe: <% %>"
+  url           = "https://example.com/unsubscribed"
+}
@@ -0,0 +1,2 @@
+terraform import sendgrid_unsubscribe_group.example 12345 # Replace with your group ID
+terraform import sendgrid_unsubscribe_group.example "subuser1,12345" # group of the subuser "subuser1"
@@ -0,0 +1,9 @@
+resource "sendgrid_unsubscribe_group" "newsletter" {
+  name        = "newsletter"
+  description = "Weekly product newsletter"
+  is_default  = false
+}
+
+output "newsletter_group_id" {
+  value = sendgrid_unsubscribe_group.newsletter.id
+}
@@ -0,0 +1,282 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &alertResource{}
+	_ resource.ResourceWithConfigure      = &alertResource{}
+	_ resource.ResourceWithImportState    = &alertResource{}
+	_ resource.ResourceWithValidateConfig = &alertResource{}
+)
+
+func NewAlertResource() resource.Resource {
+	return &alertResource{}
+}
+
+type alertResource struct {
+	client *sendgrid.Client
+}
+
+type AlertResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	EmailTo    types.String `tfsdk:"email_to"`
+	Percentage types.Int64  `tfsdk:"percentage"`
+	Frequency  types.String `tfsdk:"frequency"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *alertResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage alerts, emails sent when the usage of the plan reaches a percentage or with the statistics of the account",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the alert",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the alert, usage_limit or stats_notification",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(sendgrid.AlertTypeUsageLimit, sendgrid.AlertTypeStatsNotification),
+				},
+			},
+			"email_to": schema.StringAttribute{
+				Description: "The email address the alert is sent to",
+				Required:    true,
+			},
+			"percentage": schema.Int64Attribute{
+				Description: "The percentage of the email credits of the plan used at which the alert is sent. Required for usage_limit alerts",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"frequency": schema.StringAttribute{
+				Description: "How often the statistics are sent, daily, weekly or monthly. Required for stats_notification alerts",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("daily", "weekly", "monthly"),
+				},
+			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks that only the attribute of the alert type is set,
+// percentage for usage_limit and frequency for stats_notification.
+func (r *alertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AlertResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() || config.Type.IsNull() {
+		return
+	}
+
+	var required, conflicting string
+	var missing, conflicts bool
+	switch config.Type.ValueString() {
+	case sendgrid.AlertTypeUsageLimit:
+		required, conflicting = "percentage", "frequency"
+		missing, conflicts = config.Percentage.IsNull(), !config.Frequency.IsNull()
+	case sendgrid.AlertTypeStatsNotification:
+		required, conflicting = "frequency", "percentage"
+		missing, conflicts = config.Frequency.IsNull(), !config.Percentage.IsNull()
+	default:
+		return
+	}
+
+	if missing {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(required),
+			"Missing alert attribute",
+			fmt.Sprintf("%s is required for %s alerts.", required, config.Type.ValueString()),
+		)
+	}
+	if conflicts {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(conflicting),
+			"Conflicting alert attribute",
+			fmt.Sprintf("%s cannot be set for %s alerts.", conflicting, config.Type.ValueString()),
+		)
+	}
+}
+
+func alertState(alert *sendgrid.Alert, onBehalfOf types.String) AlertResourceModel {
+	state := AlertResourceModel{
+		ID:         types.Int64Value(alert.ID),
+		Type:       types.StringValue(alert.Type),
+		EmailTo:    types.StringValue(alert.EmailTo),
+		Percentage: types.Int64Null(),
+		Frequency:  types.StringNull(),
+		OnBehalfOf: onBehalfOf,
+	}
+
+	if alert.Percentage != 0 {
+		state.Percentage = types.Int64Value(alert.Percentage)
+	}
+	if alert.Frequency != "" {
+		state.Frequency = types.StringValue(alert.Frequency)
+	}
+
+	return state
+}
+
+func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AlertResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := clientFor(r.client, plan.OnBehalfOf).CreateAlert(ctx, sendgrid.Alert{
+		Type:       plan.Type.ValueString(),
+		EmailTo:    plan.EmailTo.ValueString(),
+		Percentage: plan.Percentage.ValueInt64(),
+		Frequency:  plan.Frequency.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating alert", "Could not create alert: ", err, "type", "email_to", "percentage", "frequency")
+		return
+	}
+
+	tflog.Debug(ctx, "Created alert", map[string]any{"id": alert.ID, "type": alert.Type})
+
+	diags = resp.State.Set(ctx, alertState(alert, plan.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AlertResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := clientFor(r.client, state.OnBehalfOf).ReadAlert(ctx, state.ID.ValueInt64())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Alert not found, removing from state", map[string]any{"id": state.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading alert",
+			fmt.Sprintf("Could not read alert %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, alertState(alert, state.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state AlertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := clientFor(r.client, state.OnBehalfOf).UpdateAlert(ctx, sendgrid.Alert{
+		ID:         state.ID.ValueInt64(),
+		EmailTo:    plan.EmailTo.ValueString(),
+		Percentage: plan.Percentage.ValueInt64(),
+		Frequency:  plan.Frequency.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating alert", "Could not update alert: ", err, "email_to", "percentage", "frequency")
+		return
+	}
+
+	diags := resp.State.Set(ctx, alertState(alert, state.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AlertResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := clientFor(r.client, state.OnBehalfOf).DeleteAlert(ctx, state.ID.ValueInt64())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting alert",
+			fmt.Sprintf("Could not delete alert %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted alert", map[string]any{"id": state.ID.ValueInt64()})
+}
+
+func (r *alertResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *alertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	onBehalfOf, importID := splitOnBehalfOfImportID(req.ID)
+	id, err := strconv.ParseInt(importID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing alert",
+			fmt.Sprintf("Alert ID must be a number, got %q: %s", importID, err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
+}
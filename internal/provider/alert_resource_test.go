@@ -0,0 +1,78 @@
+package sendgrid
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_alert" "test" {
+					type      = "usage_limit"
+					email_to  = "oncall@example.com"
+					frequency = "daily"
+				  }
+`,
+				ExpectError: regexp.MustCompile("percentage is required for usage_limit alerts"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_alert" "test" {
+					type       = "usage_limit"
+					email_to   = "oncall@example.com"
+					percentage = 90
+				  }
+
+				resource "sendgrid_alert" "stats" {
+					type      = "stats_notification"
+					email_to  = "stats@example.com"
+					frequency = "weekly"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_alert.test", "id"),
+					resource.TestCheckResourceAttr("sendgrid_alert.test", "percentage", "90"),
+					resource.TestCheckNoResourceAttr("sendgrid_alert.test", "frequency"),
+					resource.TestCheckResourceAttr("sendgrid_alert.stats", "frequency", "weekly"),
+					resource.TestCheckNoResourceAttr("sendgrid_alert.stats", "percentage"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_alert" "test" {
+					type       = "usage_limit"
+					email_to   = "ops@example.com"
+					percentage = 75
+				  }
+
+				resource "sendgrid_alert" "stats" {
+					type      = "stats_notification"
+					email_to  = "stats@example.com"
+					frequency = "monthly"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_alert.test", "email_to", "ops@example.com"),
+					resource.TestCheckResourceAttr("sendgrid_alert.test", "percentage", "75"),
+					resource.TestCheckResourceAttr("sendgrid_alert.stats", "frequency", "monthly"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -25,10 +25,11 @@ func NewApiKeyResource() resource.Resource {
 }
 
 type ApiKey struct {
-	Name   types.String `tfsdk:"name"`
-	Scopes []string     `tfsdk:"scopes"`
-	ID     types.String `tfsdk:"api_key_id"`
-	Apikey types.String `tfsdk:"api_key"`
+	Name       types.String `tfsdk:"name"`
+	Scopes     []string     `tfsdk:"scopes"`
+	ID         types.String `tfsdk:"api_key_id"`
+	Apikey     types.String `tfsdk:"api_key"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
 	//	Permission  types.String `tfsdk:"permission"`
 	//	Environment types.String `tfsdk:"environment"`
 }
@@ -92,6 +93,7 @@ func (r *apikeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "API key",
 				Computed:    true,
 			},
+			"on_behalf_of": onBehalfOfAttribute(),
 		},
 	}
 }
@@ -130,12 +132,9 @@ func (r *apikeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		Scopes: newstate.Scopes,
 	}
 
-	apikeyrespBody, err := r.client.CreateApiKey(ctx, itemapikey)
+	apikeyrespBody, err := clientFor(r.client, newstate.OnBehalfOf).CreateApiKey(ctx, itemapikey)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to create API key",
-			fmt.Sprintf("Unable to create API key: %s", err.Error()),
-		)
+		addClientError(&resp.Diagnostics, "Unable to create API key", "Unable to create API key: ", err, "name", "scopes")
 
 		return
 	}
@@ -148,10 +147,11 @@ func (r *apikeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		listmyvalue = append(listmyvalue, "")
 	}
 	newstate = ApiKey{
-		ID:     types.StringValue(apikeyrespBody.ID),
-		Name:   types.StringValue(apikeyrespBody.Name),
-		Scopes: listmyvalue,
-		Apikey: types.StringValue(apikeyrespBody.Apikey),
+		ID:         types.StringValue(apikeyrespBody.ID),
+		Name:       types.StringValue(apikeyrespBody.Name),
+		Scopes:     listmyvalue,
+		Apikey:     types.StringValue(apikeyrespBody.Apikey),
+		OnBehalfOf: newstate.OnBehalfOf,
 	}
 
 	diags = resp.State.Set(ctx, &newstate)
@@ -172,8 +172,14 @@ func (r *apikeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	readapikeyresponse, err := r.client.ReadApiKey(ctx, readstate.ID.ValueString())
+	readapikeyresponse, err := clientFor(r.client, readstate.OnBehalfOf).ReadApiKey(ctx, readstate.ID.ValueString())
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "API key not found, removing from state", map[string]any{"api_key_id": readstate.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Unable to read API key",
 			fmt.Sprintf("Unable to read API key: %s", err),
@@ -190,10 +196,11 @@ func (r *apikeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		readlistmyvalue = append(readlistmyvalue, "")
 	}
 	readstate = ApiKey{
-		ID:     types.StringValue(readapikeyresponse.ID),
-		Name:   types.StringValue(readapikeyresponse.Name),
-		Scopes: readlistmyvalue,
-		Apikey: types.StringValue(readapikeyresponse.Apikey),
+		ID:         types.StringValue(readapikeyresponse.ID),
+		Name:       types.StringValue(readapikeyresponse.Name),
+		Scopes:     readlistmyvalue,
+		Apikey:     types.StringValue(readapikeyresponse.Apikey),
+		OnBehalfOf: readstate.OnBehalfOf,
 	}
 
 	diags = resp.State.Set(ctx, readstate)
@@ -225,12 +232,9 @@ func (r *apikeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		ID:     updatestate.ID.ValueString(),
 	}
 
-	updateapikeyrespBody, err := r.client.UpdateApiKey(ctx, updateitemapikey)
+	updateapikeyrespBody, err := clientFor(r.client, updatestate.OnBehalfOf).UpdateApiKey(ctx, updateitemapikey)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to update API key Permission",
-			fmt.Sprintf("Unable to update API key: %s", err),
-		)
+		addClientError(&resp.Diagnostics, "Unable to update API key Permission", "Unable to update API key: ", err, "name", "scopes")
 
 		return
 	}
@@ -243,9 +247,10 @@ func (r *apikeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		updatelistmyvalue = append(updatelistmyvalue, "")
 	}
 	updatestate = ApiKey{
-		ID:     types.StringValue(updateapikeyrespBody.ID),
-		Name:   types.StringValue(updateapikeyrespBody.Name),
-		Scopes: updatelistmyvalue,
+		ID:         types.StringValue(updateapikeyrespBody.ID),
+		Name:       types.StringValue(updateapikeyrespBody.Name),
+		Scopes:     updatelistmyvalue,
+		OnBehalfOf: updatestate.OnBehalfOf,
 	}
 
 	//resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scop"), updateplan.Permission)...)
@@ -272,7 +277,7 @@ func (r *apikeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, err = r.client.DeleteApiKey(ctx, deletestate.ID.ValueString())
+	_, err = clientFor(r.client, deletestate.OnBehalfOf).DeleteApiKey(ctx, deletestate.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete API key",
@@ -285,5 +290,7 @@ func (r *apikeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *apikeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("api_key_id"), req, resp)
+	onBehalfOf, id := splitOnBehalfOfImportID(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_key_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
 }
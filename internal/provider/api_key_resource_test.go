@@ -1,9 +1,11 @@
 package sendgrid
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccapikeyResource(t *testing.T) {
@@ -13,47 +15,51 @@ func TestAccapikeyResource(t *testing.T) {
 			// Create and Read testing
 			{
 				Config: providerConfig + `
-				resource "sendgrid_api_key" "name" {
-					name = "test"
-					permission = "bill" # "full" or "bill" or "custom"
+				resource "sendgrid_api_key" "test" {
+					name   = "test"
+					scopes = ["mail.send"]
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify number of items
 					// Verify first order item
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "name", "test"),
+					resource.TestCheckResourceAttr("sendgrid_api_key.test", "name", "test"),
+					resource.TestCheckResourceAttr("sendgrid_api_key.test", "scopes.#", "1"),
 					// Verify dynamic values have any value set in the state.
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "id"),
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "permission"),
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "api_key"),
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "api_key_id"),
+					resource.TestCheckResourceAttrSet("sendgrid_api_key.test", "api_key"),
+					resource.TestCheckResourceAttrSet("sendgrid_api_key.test", "api_key_id"),
 				),
 			},
 			// ImportState testing
 			{
-				ResourceName:      "sendgrid_domain_authentication.test",
+				ResourceName:      "sendgrid_api_key.test",
 				ImportState:       true,
 				ImportStateVerify: true,
-				// The last_updated attribute does not exist in the sendgrid
-				// API, therefore there is no value for it during import.
-				//				ImportStateVerifyIgnore: []string{"last_updated"},
+				// The resource has no id attribute.
+				ImportStateVerifyIdentifierAttribute: "api_key_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["sendgrid_api_key.test"]
+					if !ok {
+						return "", fmt.Errorf("sendgrid_api_key.test not found in state")
+					}
+					return rs.Primary.Attributes["api_key_id"], nil
+				},
+				// The key itself is only returned when it is created.
+				ImportStateVerifyIgnore: []string{"api_key"},
 			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
-				resource "sendgrid_api_key" "name" {
-					name = "test"
-					permission = "bill" # "full" or "bill" or "custom"
+				resource "sendgrid_api_key" "test" {
+					name   = "test-renamed"
+					scopes = ["mail.send", "alerts.read"]
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify first order item updated
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "name", "test"),
+					resource.TestCheckResourceAttr("sendgrid_api_key.test", "name", "test-renamed"),
+					resource.TestCheckResourceAttr("sendgrid_api_key.test", "scopes.#", "2"),
 					// Verify dynamic values have any value set in the state.
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "id"),
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "permission"),
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "api_key"),
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "api_key_id"),
+					resource.TestCheckResourceAttrSet("sendgrid_api_key.test", "api_key_id"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
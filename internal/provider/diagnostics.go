@@ -0,0 +1,55 @@
+package sendgrid
+
+import (
+	"errors"
+	"strings"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// addClientError appends err to diags. Field level errors returned by the
+// SendGrid API for one of the given attributes are reported against that
+// attribute, anything else is reported as a general error prefixed with
+// detail.
+func addClientError(diags *diag.Diagnostics, summary string, detail string, err error, attributes ...string) {
+	var apiErr *sendgrid.APIError
+	if errors.As(err, &apiErr) && len(apiErr.Errors) > 0 {
+		var unattributed []sendgrid.APIErrorItem
+		for _, item := range apiErr.Errors {
+			if item.Field != "" && containsString(attributes, item.Field) {
+				message := item.Message
+				if apiErr.RequestID != "" {
+					message += " (request id: " + apiErr.RequestID + ")"
+				}
+				diags.AddAttributeError(path.Root(item.Field), summary, message)
+			} else {
+				unattributed = append(unattributed, item)
+			}
+		}
+		if len(unattributed) == 0 {
+			return
+		}
+		if len(unattributed) < len(apiErr.Errors) {
+			// Only report what was not already added as an attribute error,
+			// keeping any context the client wrapped around the API error.
+			rest := *apiErr
+			rest.Errors = unattributed
+			diags.AddError(summary, detail+strings.Replace(err.Error(), apiErr.Error(), rest.Error(), 1))
+			return
+		}
+	}
+
+	diags.AddError(summary, detail+err.Error())
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package sendgrid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestAddClientErrorReportsFieldErrorsOnce(t *testing.T) {
+	err := fmt.Errorf("CreateSubuser: %w", &sendgrid.APIError{
+		StatusCode: 400,
+		Method:     "POST",
+		Endpoint:   "/subusers",
+		Errors: []sendgrid.APIErrorItem{
+			{Field: "email", Message: "email is invalid"},
+			{Field: "ips", Message: "ip is not assigned"},
+		},
+	})
+
+	var diags diag.Diagnostics
+	addClientError(&diags, "Error creating subuser", "Could not create subuser: ", err, "email")
+
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d: %v", len(diags), diags)
+	}
+
+	attrDiag, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !attrDiag.Path().Equal(path.Root("email")) || attrDiag.Detail() != "email is invalid" {
+		t.Errorf("expected an attribute error on email, got %v", diags[0])
+	}
+
+	detail := diags[1].Detail()
+	if !strings.HasPrefix(detail, "Could not create subuser: CreateSubuser: ") {
+		t.Errorf("expected the general error to keep its context, got %q", detail)
+	}
+	if strings.Contains(detail, "email is invalid") {
+		t.Errorf("expected the attribute error not to be repeated, got %q", detail)
+	}
+	if !strings.Contains(detail, "ips: ip is not assigned") {
+		t.Errorf("expected the unattributed error to be reported, got %q", detail)
+	}
+}
+
+func TestAddClientErrorWithoutAPIError(t *testing.T) {
+	var diags diag.Diagnostics
+	addClientError(&diags, "Error creating subuser", "Could not create subuser: ", errors.New("boom"), "email")
+
+	if len(diags) != 1 || diags[0].Detail() != "Could not create subuser: boom" {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
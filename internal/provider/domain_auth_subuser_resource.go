@@ -122,6 +122,12 @@ func (r *domainsubuserResource) Read(ctx context.Context, req resource.ReadReque
 
 	domainsubuser, err := r.client.GetDomainSubuser(ctx, domainreadsubitem)
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Domain subuser association not found, removing from state", map[string]any{"id": domainsubuserstate.ID.ValueInt64(), "username": domainsubuserstate.Username.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error Reading Domain Subuser",
 			fmt.Sprintf("Error reading domain subuser: %s", err.Error()),
@@ -205,5 +211,5 @@ func (r *domainsubuserResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	r.client = client
+	r.client = client.Parent()
 }
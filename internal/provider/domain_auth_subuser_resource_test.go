@@ -7,47 +7,43 @@ import (
 )
 
 func TestAccdomainauthsubuserResource(t *testing.T) {
+	config := providerConfig + `
+				resource "sendgrid_domain_authentication" "test" {
+					environment = "nonprod"
+					domain      = "subuser.example.com"
+					ips         = ["192.0.2.1"]
+				  }
+
+				resource "sendgrid_subuser" "test" {
+					email    = "domain@example.com"
+					username = "domain.test"
+					ips      = ["192.0.2.1"]
+					password = "C3|zh!%SR],jgD5d"
+				  }
+
+				resource "sendgrid_domainauth_add_subuser" "asub" {
+					id       = sendgrid_domain_authentication.test.id
+					username = sendgrid_subuser.test.username
+				  }
+`
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: providerConfig + `
-				resource "sendgrid_domainauth_add_subuser" "asub" {
-					id = sendgrid_domain_authentication.name.id
-					username = "sk.dev"
-				  }
-`,
+				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify number of items
-					// Verify first order item
-					resource.TestCheckResourceAttr("sendgrid_domainauth_add_subuser.asub", "domain", "example.com"),
-					resource.TestCheckResourceAttr("sendgrid_domainauth_add_subuser.asub", "username", "sk.dev"),
-					resource.TestCheckResourceAttrSet("sendgrid_domainauth_add_subuser.asub", "subusers"),
+					resource.TestCheckResourceAttrPair("sendgrid_domainauth_add_subuser.asub", "id", "sendgrid_domain_authentication.test", "id"),
+					resource.TestCheckResourceAttr("sendgrid_domainauth_add_subuser.asub", "username", "domain.test"),
+					resource.TestCheckResourceAttrSet("sendgrid_domainauth_add_subuser.asub", "user_id"),
 				),
 			},
-			// ImportState testing
-			{
-				ResourceName:      "sendgrid_domainauth_add_subuser.asub",
-				ImportState:       true,
-				ImportStateVerify: true,
-				// The last_updated attribute does not exist in the HashiCups
-				// API, therefore there is no value for it during import.
-				//				ImportStateVerifyIgnore: []string{"last_updated"},
-			},
-			// Update and Read testing
+			// Read testing, the association is kept on refresh
 			{
-				Config: providerConfig + `
-				resource "sendgrid_domainauth_add_subuser" "asub" {
-					id = sendgrid_domain_authentication.name.id
-					username = "sk.dev"
-				  }
-`,
+				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify first order item updated
-					resource.TestCheckResourceAttr("sendgrid_domainauth_add_subuser.asub", "domain", "example.com"),
-					resource.TestCheckResourceAttr("sendgrid_domainauth_add_subuser.asub", "username", "sk.dev"),
-					resource.TestCheckResourceAttrSet("sendgrid_domainauth_add_subuser.asub", "subusers"),
+					resource.TestCheckResourceAttr("sendgrid_domainauth_add_subuser.asub", "username", "domain.test"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
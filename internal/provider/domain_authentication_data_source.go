@@ -5,9 +5,12 @@ import (
 	"fmt"
 	sendgrid "terraform-provider-sendgrid/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -51,31 +54,44 @@ func (d *domainAuthDataSource) Metadata(_ context.Context, req datasource.Metada
 
 func (d *domainAuthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Allows to retrive authenticataed domain details",
+		Description: "Allows to retrive authenticataed domain details, either by ID or by domain name",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				Description: "The ID of the domain authentication",
-				Required:    true,
+				Description: "The ID of the domain authentication. Conflicts with domain",
+				Optional:    true,
+				Computed:    true,
 			},
 			"user_id": schema.Int64Attribute{
 				Description: "The ID of the user",
 				Computed:    true,
 			},
 			"domain": schema.StringAttribute{
-				Description: "The domain name",
+				Description: "The domain name to look up. Conflicts with id",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id")),
+				},
 			},
 			"custom_dkim": schema.StringAttribute{
 				Description: "The custom DKIM",
 				Computed:    true,
 			},
 			"subdomain": schema.StringAttribute{
-				Description: "The subdomain name",
+				Description: "The subdomain name. Narrows down the lookup by domain",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("domain")),
+				},
 			},
 			"username": schema.StringAttribute{
-				Description: "The username",
+				Description: "The username owning the domain. Narrows down the lookup by domain",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("domain")),
+				},
 			},
 			"ips": schema.ListAttribute{
 				Description: "The list of IP addresses",
@@ -201,8 +217,22 @@ func (d *domainAuthDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		)
 		return
 	}
-	refitem, err := d.client.GetDomainAuth(ctx, sendgrid.DomainAuth{ID: refstate.ID.ValueInt64()})
+	lookup := sendgrid.DomainAuth{
+		ID:        refstate.ID.ValueInt64(),
+		Domain:    refstate.Domain.ValueString(),
+		Subdomain: refstate.Subdomain.ValueString(),
+		Username:  refstate.Username.ValueString(),
+	}
+
+	refitem, err := d.client.GetDomainAuth(ctx, lookup)
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Domain authentication not found",
+				fmt.Sprintf("No authenticated domain matches the given id or domain: %s", err),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading domain authentication",
 			fmt.Sprintf("Error reading domain authentication: %s", err),
@@ -10,55 +10,59 @@ func TestAccdomainauthDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Create and Read testing
+			// Read testing
 			{
 				Config: providerConfig + `
+				resource "sendgrid_domain_authentication" "test" {
+					environment = "nonprod"
+					domain      = "data.example.com"
+					ips         = ["192.0.2.1"]
+				  }
+
 				data "sendgrid_domain_authentication" "test" {
-					id = 123456789
+					id = sendgrid_domain_authentication.test.id
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify number of items
 					// Verify first order item
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "domain", "example.com"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "subdomain", ""),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "custom_spf", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "default", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "auto_Security", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "valid", "false"),
+					resource.TestCheckResourceAttr("data.sendgrid_domain_authentication.test", "domain", "data.example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_domain_authentication.test", "custom_spf", "false"),
+					resource.TestCheckResourceAttr("data.sendgrid_domain_authentication.test", "default", "false"),
+					resource.TestCheckResourceAttr("data.sendgrid_domain_authentication.test", "valid", "false"),
 					// Verify dynamic values have any value set in the state.
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "id"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_domain_authentication.test", "id", "sendgrid_domain_authentication.test", "id"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_domain_authentication.test", "subdomain", "sendgrid_domain_authentication.test", "subdomain"),
 				),
 			},
-			// ImportState testing
-			{
-				ResourceName:      "sendgrid_domain_authentication.test",
-				ImportState:       true,
-				ImportStateVerify: true,
-				// The last_updated attribute does not exist in the sendgrid
-				// API, therefore there is no value for it during import.
-				//				ImportStateVerifyIgnore: []string{"last_updated"},
-			},
-			// Update and Read testing
+		},
+	})
+}
+
+func TestAccdomainauthDataSourceByDomain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
 			{
 				Config: providerConfig + `
-				data "sendgrid_domain_authentication" "name" {
-					id = 123456789
+				resource "sendgrid_domain_authentication" "test" {
+					environment = "nonprod"
+					domain      = "example.com"
+					subdomain   = "em"
+					ips         = ["192.0.2.1"]
+				  }
+
+				data "sendgrid_domain_authentication" "test" {
+					domain    = sendgrid_domain_authentication.test.domain
+					subdomain = sendgrid_domain_authentication.test.subdomain
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify first order item updated
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "domain", "example.com"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "subdomain", ""),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "custom_spf", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "default", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "auto_Security", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "valid", "false"),
-					// Verify dynamic values have any value set in the state.
-					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "id"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_domain_authentication.test", "id", "sendgrid_domain_authentication.test", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_domain_authentication.test", "mail_cname.host", "em.example.com"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_domain_authentication.test", "dkim1.data"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_domain_authentication.test", "dkim2.data"),
 				),
 			},
-			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
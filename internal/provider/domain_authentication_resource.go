@@ -8,7 +8,6 @@ import (
 
 	sendgrid "terraform-provider-sendgrid/client"
 
-	backoff "github.com/cenkalti/backoff"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -158,6 +158,7 @@ func (r *domainauthResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:    true,
 				Optional:    true,
 				ElementType: types.StringType,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
@@ -365,25 +366,10 @@ func (r *domainauthResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	//newItem, err := r.client.CreateDomainAuth(ctx, itemState)
-
-	// create retrycontext with backoff
-	retryctx := backoff.WithContext(backoff.NewExponentialBackOff(), ctx)
-
-	var newItem *sendgrid.DomainAuth
-	var err error
-	err = backoff.Retry(func() error {
-		newItem, err = r.client.CreateDomainAuth(ctx, itemState)
-		return err
-	}, retryctx)
-
-	//newItem, err := newstate.Timeout.Create(ctx, func(ctx context.Context) (interface{}, error) {
-
+	// transient failures are retried by the client, see client/retry.go
+	newItem, err := r.client.CreateDomainAuth(ctx, itemState)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating domain authentication",
-			fmt.Sprintf("Error creating domain authentication: %s", err),
-		)
+		addClientError(&resp.Diagnostics, "Error creating domain authentication", "Error creating domain authentication: ", err, "domain", "subdomain", "ips", "custom_spf", "default")
 		return
 	}
 
@@ -558,6 +544,12 @@ func (r *domainauthResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	readitem, err := r.client.GetDomainAuth(ctx, sendgrid.DomainAuth{ID: readstate.ID.ValueInt64()})
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Domain authentication not found, removing from state", map[string]any{"id": readstate.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error reading domain authentication",
 			fmt.Sprintf("Error reading domain authentication: %s", err),
@@ -739,8 +731,8 @@ func (r *domainauthResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	itemState := sendgrid.DomainAuth{
 		ID:            updatestate.ID.ValueInt64(),
-		CustomSPF:     updatestate.CusomSPF.ValueBool(),
-		Defaultdomain: updatestate.Defaultdomain.ValueBool(),
+		CustomSPF:     updateplan.CusomSPF.ValueBool(),
+		Defaultdomain: updateplan.Defaultdomain.ValueBool(),
 		Valid:         updatestate.Valid.ValueBool(),
 	}
 
@@ -750,20 +742,17 @@ func (r *domainauthResource) Update(ctx context.Context, req resource.UpdateRequ
 
 		updaterespBody, err := r.client.UpdateDomainAuth(ctx, itemState)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating domain authentication",
-				fmt.Sprintf("Error updating domain authentication: %s", err),
-			)
+			addClientError(&resp.Diagnostics, "Error updating domain authentication", "Error updating domain authentication: ", err, "custom_spf", "default")
 			return
 		}
 
-		if updatestate.CusomSPF.ValueBool() && !updaterespBody.CustomSPF {
+		if updateplan.CusomSPF.ValueBool() && !updaterespBody.CustomSPF {
 			resp.Diagnostics.AddError(
 				"Error updating domain authentication",
 				fmt.Sprintf("Error updating domain authentication: %s", "Failed to set custom_spf true."),
 			)
 			return
-		} else if updatestate.Defaultdomain.ValueBool() && !updaterespBody.Defaultdomain {
+		} else if updateplan.Defaultdomain.ValueBool() && !updaterespBody.Defaultdomain {
 			resp.Diagnostics.AddError(
 				"Error updating domain authentication",
 				fmt.Sprintf("Error updating domain authentication: %s", "Failed to set default true."),
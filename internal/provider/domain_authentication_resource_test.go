@@ -13,30 +13,25 @@ func TestAccdomainauthResource(t *testing.T) {
 			// Create and Read testing
 			{
 				Config: providerConfig + `
-				resource "sendgrid_domain_authentication" "name" {
-  
-					domain = "example.com"
-					custom_dkim = "tss" # only 3 characters are allowed. it can be any 3 characters.
-					ips = [
-					  ""
-					]
-					custom_spf = false
-					default = false # if you want to make this domain as default domain then change this value to true.
-					auto_security = false
-					valid = false # once domain created updated the dns/route 53 records and then run terraform apply again by changing this value true.
+				resource "sendgrid_domain_authentication" "test" {
+					environment = "nonprod"
+					domain      = "example.com"
+					ips         = ["192.0.2.1"]
+					custom_spf  = false
+					default     = false
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify number of items
 					// Verify first order item
 					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "domain", "example.com"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "subdomain", ""),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "custom_dkim_selector", "sn1"),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "ips.0", "192.0.2.1"),
 					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "custom_spf", "false"),
 					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "default", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "auto_Security", "false"),
 					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "valid", "false"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "id"),
+					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "dkim1.host"),
 				),
 			},
 			// ImportState testing
@@ -44,34 +39,26 @@ func TestAccdomainauthResource(t *testing.T) {
 				ResourceName:      "sendgrid_domain_authentication.test",
 				ImportState:       true,
 				ImportStateVerify: true,
-				// The last_updated attribute does not exist in the sendgrid
+				// The environment attribute does not exist in the sendgrid
 				// API, therefore there is no value for it during import.
-				//				ImportStateVerifyIgnore: []string{"last_updated"},
+				ImportStateVerifyIgnore: []string{"environment"},
 			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
-				resource "sendgrid_domain_authentication" "name" {
-  
-					domain = "example.com"
-					custom_dkim = "tss" # only 3 characters are allowed. it can be any 3 characters.
-					ips = [
-					  ""
-					]
-					custom_spf = false
-					default = false # if you want to make this domain as default domain then change this value to true.
-					auto_security = false
-					valid = false # once domain created updated the dns/route 53 records and then run terraform apply again by changing this value true.
+				resource "sendgrid_domain_authentication" "test" {
+					environment = "nonprod"
+					domain      = "example.com"
+					ips         = ["192.0.2.1"]
+					custom_spf  = true
+					default     = true
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify first order item updated
 					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "domain", "example.com"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "subdomain", ""),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "custom_spf", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "default", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "auto_Security", "false"),
-					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "valid", "false"),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "custom_spf", "true"),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "default", "true"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.test", "id"),
 				),
@@ -120,6 +120,12 @@ func (r *domainvalidateResource) Read(ctx context.Context, req resource.ReadRequ
 
 	domainvalreaditem, err := r.client.GetDomainAuth(ctx, sendgrid.DomainAuth{ID: domainvalreadstate.ID.ValueInt64()})
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Domain authentication not found, removing from state", map[string]any{"id": domainvalreadstate.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error reading domain",
 			fmt.Sprintf("Error reading domain: %s", err),
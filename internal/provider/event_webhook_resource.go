@@ -0,0 +1,376 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &eventWebhookResource{}
+	_ resource.ResourceWithConfigure   = &eventWebhookResource{}
+	_ resource.ResourceWithImportState = &eventWebhookResource{}
+	_ resource.ResourceWithModifyPlan  = &eventWebhookResource{}
+)
+
+// eventWebhookID is the ID of sendgrid_event_webhook, an account has a
+// single event webhook.
+const eventWebhookID = "event_webhook"
+
+func NewEventWebhookResource() resource.Resource {
+	return &eventWebhookResource{}
+}
+
+type eventWebhookResource struct {
+	client *sendgrid.Client
+}
+
+type EventWebhookResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	URL               types.String `tfsdk:"url"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Processed         types.Bool   `tfsdk:"processed"`
+	Delivered         types.Bool   `tfsdk:"delivered"`
+	Deferred          types.Bool   `tfsdk:"deferred"`
+	Dropped           types.Bool   `tfsdk:"dropped"`
+	Bounce            types.Bool   `tfsdk:"bounce"`
+	Open              types.Bool   `tfsdk:"open"`
+	Click             types.Bool   `tfsdk:"click"`
+	SpamReport        types.Bool   `tfsdk:"spam_report"`
+	Unsubscribe       types.Bool   `tfsdk:"unsubscribe"`
+	GroupUnsubscribe  types.Bool   `tfsdk:"group_unsubscribe"`
+	GroupResubscribe  types.Bool   `tfsdk:"group_resubscribe"`
+	OAuthClientID     types.String `tfsdk:"oauth_client_id"`
+	OAuthClientSecret types.String `tfsdk:"oauth_client_secret"`
+	OAuthTokenURL     types.String `tfsdk:"oauth_token_url"`
+	Signed            types.Bool   `tfsdk:"signed"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	OnBehalfOf        types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *eventWebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_webhook"
+}
+
+// eventAttribute is the schema of the toggle posting one type of event.
+func eventAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: description + ". Defaults to false",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+}
+
+func (r *eventWebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage the event webhook settings of the account. Destroying the resource disables the webhook and every event",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Always event_webhook",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL events are posted to",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the webhook is enabled. Defaults to true",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"processed":         eventAttribute("Whether to post processed events, a message was received and is ready to be delivered"),
+			"delivered":         eventAttribute("Whether to post delivered events, a message was accepted by the receiving server"),
+			"deferred":          eventAttribute("Whether to post deferred events, the receiving server temporarily rejected a message"),
+			"dropped":           eventAttribute("Whether to post dropped events, a message was not sent"),
+			"bounce":            eventAttribute("Whether to post bounce events, the receiving server rejected a message"),
+			"open":              eventAttribute("Whether to post open events"),
+			"click":             eventAttribute("Whether to post click events"),
+			"spam_report":       eventAttribute("Whether to post spam report events"),
+			"unsubscribe":       eventAttribute("Whether to post unsubscribe events"),
+			"group_unsubscribe": eventAttribute("Whether to post unsubscribe group unsubscribe events"),
+			"group_resubscribe": eventAttribute("Whether to post unsubscribe group resubscribe events"),
+			"oauth_client_id": schema.StringAttribute{
+				Description: "The client ID used to request an OAuth token before posting events",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("oauth_client_secret"), path.MatchRoot("oauth_token_url")),
+				},
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				Description: "The client secret used to request an OAuth token. SendGrid never returns it, changes made outside of Terraform are not detected",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("oauth_client_id")),
+				},
+			},
+			"oauth_token_url": schema.StringAttribute{
+				Description: "The URL to request an OAuth token from",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("oauth_client_id")),
+				},
+			},
+			"signed": schema.BoolAttribute{
+				Description: "Whether events are signed. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The public key to verify the signature of events with, empty when signed is false. A new key is generated every time signing is enabled",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+// ModifyPlan marks the public key as unknown when signing is toggled, the
+// key is kept from the state otherwise.
+func (r *eventWebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state EventWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Signed.IsUnknown() || !plan.Signed.Equal(state.Signed) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_key"), types.StringUnknown())...)
+	}
+}
+
+func eventWebhookFromPlan(plan EventWebhookResourceModel) sendgrid.EventWebhook {
+	return sendgrid.EventWebhook{
+		Enabled:           plan.Enabled.ValueBool(),
+		URL:               plan.URL.ValueString(),
+		Processed:         plan.Processed.ValueBool(),
+		Delivered:         plan.Delivered.ValueBool(),
+		Deferred:          plan.Deferred.ValueBool(),
+		Dropped:           plan.Dropped.ValueBool(),
+		Bounce:            plan.Bounce.ValueBool(),
+		Open:              plan.Open.ValueBool(),
+		Click:             plan.Click.ValueBool(),
+		SpamReport:        plan.SpamReport.ValueBool(),
+		Unsubscribe:       plan.Unsubscribe.ValueBool(),
+		GroupUnsubscribe:  plan.GroupUnsubscribe.ValueBool(),
+		GroupResubscribe:  plan.GroupResubscribe.ValueBool(),
+		OAuthClientID:     plan.OAuthClientID.ValueString(),
+		OAuthClientSecret: plan.OAuthClientSecret.ValueString(),
+		OAuthTokenURL:     plan.OAuthTokenURL.ValueString(),
+	}
+}
+
+// eventWebhookState builds the state of webhook. The OAuth client secret is
+// never returned by SendGrid and is kept from prior.
+func eventWebhookState(webhook *sendgrid.EventWebhook, publicKey string, prior EventWebhookResourceModel) EventWebhookResourceModel {
+	state := EventWebhookResourceModel{
+		ID:                types.StringValue(eventWebhookID),
+		URL:               types.StringValue(webhook.URL),
+		Enabled:           types.BoolValue(webhook.Enabled),
+		Processed:         types.BoolValue(webhook.Processed),
+		Delivered:         types.BoolValue(webhook.Delivered),
+		Deferred:          types.BoolValue(webhook.Deferred),
+		Dropped:           types.BoolValue(webhook.Dropped),
+		Bounce:            types.BoolValue(webhook.Bounce),
+		Open:              types.BoolValue(webhook.Open),
+		Click:             types.BoolValue(webhook.Click),
+		SpamReport:        types.BoolValue(webhook.SpamReport),
+		Unsubscribe:       types.BoolValue(webhook.Unsubscribe),
+		GroupUnsubscribe:  types.BoolValue(webhook.GroupUnsubscribe),
+		GroupResubscribe:  types.BoolValue(webhook.GroupResubscribe),
+		OAuthClientID:     types.StringNull(),
+		OAuthClientSecret: prior.OAuthClientSecret,
+		OAuthTokenURL:     types.StringNull(),
+		Signed:            types.BoolValue(publicKey != ""),
+		PublicKey:         types.StringValue(publicKey),
+		OnBehalfOf:        prior.OnBehalfOf,
+	}
+
+	if webhook.OAuthClientID != "" {
+		state.OAuthClientID = types.StringValue(webhook.OAuthClientID)
+	}
+	if webhook.OAuthTokenURL != "" {
+		state.OAuthTokenURL = types.StringValue(webhook.OAuthTokenURL)
+	}
+
+	return state
+}
+
+func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EventWebhookResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, plan.OnBehalfOf)
+
+	webhook, err := client.UpdateEventWebhook(ctx, eventWebhookFromPlan(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error configuring event webhook", "Could not configure event webhook: ", err, eventWebhookAttributes...)
+		return
+	}
+
+	publicKey, err := client.SetEventWebhookSigning(ctx, plan.Signed.ValueBool())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error configuring event webhook signing", "Could not configure event webhook signing: ", err, "signed")
+		return
+	}
+
+	tflog.Debug(ctx, "Configured event webhook", map[string]any{"url": webhook.URL, "signed": publicKey != ""})
+
+	diags = resp.State.Set(ctx, eventWebhookState(webhook, publicKey, plan))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *eventWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EventWebhookResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, state.OnBehalfOf)
+
+	webhook, err := client.ReadEventWebhook(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading event webhook",
+			"Could not read event webhook: "+err.Error(),
+		)
+		return
+	}
+
+	publicKey, err := client.ReadEventWebhookPublicKey(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading event webhook signing",
+			"Could not read event webhook signing: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, eventWebhookState(webhook, publicKey, state))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *eventWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state EventWebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, state.OnBehalfOf)
+
+	webhook, err := client.UpdateEventWebhook(ctx, eventWebhookFromPlan(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error configuring event webhook", "Could not configure event webhook: ", err, eventWebhookAttributes...)
+		return
+	}
+
+	publicKey := state.PublicKey.ValueString()
+	if !plan.Signed.Equal(state.Signed) {
+		publicKey, err = client.SetEventWebhookSigning(ctx, plan.Signed.ValueBool())
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error configuring event webhook signing", "Could not configure event webhook signing: ", err, "signed")
+			return
+		}
+	}
+
+	diags := resp.State.Set(ctx, eventWebhookState(webhook, publicKey, plan))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete disables the webhook, every event and signing.
+func (r *eventWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EventWebhookResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, state.OnBehalfOf)
+
+	if _, err := client.UpdateEventWebhook(ctx, sendgrid.EventWebhook{}); err != nil {
+		resp.Diagnostics.AddError(
+			"Error disabling event webhook",
+			"Could not disable event webhook: "+err.Error(),
+		)
+		return
+	}
+
+	if _, err := client.SetEventWebhookSigning(ctx, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error disabling event webhook signing",
+			"Could not disable event webhook signing: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Disabled event webhook")
+}
+
+func (r *eventWebhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState accepts any ID, optionally prefixed with the subuser to
+// import the webhook of.
+func (r *eventWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	onBehalfOf, _ := splitOnBehalfOfImportID(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), eventWebhookID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
+}
+
+var eventWebhookAttributes = []string{"url", "oauth_client_id", "oauth_client_secret", "oauth_token_url"}
@@ -0,0 +1,58 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEventWebhookResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_event_webhook" "test" {
+					url       = "https://hooks.example.com/sendgrid"
+					delivered = true
+					bounce    = true
+					signed    = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "delivered", "true"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "open", "false"),
+					resource.TestCheckResourceAttrSet("sendgrid_event_webhook.test", "public_key"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_event_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_event_webhook" "test" {
+					url                 = "https://hooks.example.com/sendgrid"
+					open                = true
+					oauth_client_id     = "client"
+					oauth_client_secret = "secret"
+					oauth_token_url     = "https://auth.example.com/token"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "delivered", "false"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "open", "true"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "oauth_client_id", "client"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "signed", "false"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "public_key", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,195 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &globalSuppressionResource{}
+	_ resource.ResourceWithConfigure = &globalSuppressionResource{}
+)
+
+// globalSuppressionID is the ID of every sendgrid_global_suppression. The
+// account has a single global suppression list, the resource only manages
+// the emails it lists.
+const globalSuppressionID = "global"
+
+func NewGlobalSuppressionResource() resource.Resource {
+	return &globalSuppressionResource{}
+}
+
+type globalSuppressionResource struct {
+	client *sendgrid.Client
+}
+
+type GlobalSuppressionResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Emails     []string     `tfsdk:"emails"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *globalSuppressionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_suppression"
+}
+
+func (r *globalSuppressionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to suppress emails globally, no email of the account is delivered to them. Other emails on the global suppression list are left alone",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Always global",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emails":       suppressedEmailsAttribute("The emails to suppress globally"),
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *globalSuppressionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GlobalSuppressionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := clientFor(r.client, plan.OnBehalfOf).AddGlobalSuppressions(ctx, plan.Emails)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error adding global suppressions", "Could not add global suppressions: ", err, "emails")
+		return
+	}
+
+	tflog.Debug(ctx, "Added global suppressions", map[string]any{"count": len(plan.Emails)})
+
+	plan.ID = types.StringValue(globalSuppressionID)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *globalSuppressionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GlobalSuppressionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, state.OnBehalfOf)
+
+	var found []string
+	for _, email := range state.Emails {
+		suppressed, err := client.IsGloballySuppressed(ctx, email)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading global suppressions",
+				fmt.Sprintf("Could not read global suppression of %s: %s", email, err),
+			)
+			return
+		}
+		if suppressed {
+			found = append(found, email)
+		}
+	}
+
+	if len(found) == 0 {
+		tflog.Warn(ctx, "No managed email is globally suppressed anymore, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Emails = found
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *globalSuppressionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state GlobalSuppressionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, state.OnBehalfOf)
+	added, removed := diffEmails(state.Emails, plan.Emails)
+
+	if len(added) > 0 {
+		if _, err := client.AddGlobalSuppressions(ctx, added); err != nil {
+			addClientError(&resp.Diagnostics, "Error adding global suppressions", "Could not add global suppressions: ", err, "emails")
+			return
+		}
+	}
+
+	for _, email := range removed {
+		if _, err := client.DeleteGlobalSuppression(ctx, email); err != nil && !sendgrid.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error removing global suppression",
+				fmt.Sprintf("Could not remove global suppression of %s: %s", email, err),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Updated global suppressions", map[string]any{"added": len(added), "removed": len(removed)})
+
+	plan.ID = state.ID
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *globalSuppressionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GlobalSuppressionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, state.OnBehalfOf)
+	for _, email := range state.Emails {
+		if _, err := client.DeleteGlobalSuppression(ctx, email); err != nil && !sendgrid.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error removing global suppression",
+				fmt.Sprintf("Could not remove global suppression of %s: %s", email, err),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Removed global suppressions", map[string]any{"count": len(state.Emails)})
+}
+
+func (r *globalSuppressionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,41 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGlobalSuppressionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_global_suppression" "test" {
+					emails = ["abuse@example.com", "legal@example.com"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_global_suppression.test", "id", "global"),
+					resource.TestCheckResourceAttr("sendgrid_global_suppression.test", "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_global_suppression.test", "emails.*", "abuse@example.com"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_global_suppression" "test" {
+					emails = ["abuse@example.com", "compliance@example.com"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_global_suppression.test", "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_global_suppression.test", "emails.*", "compliance@example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,235 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &groupSuppressionResource{}
+	_ resource.ResourceWithConfigure   = &groupSuppressionResource{}
+	_ resource.ResourceWithImportState = &groupSuppressionResource{}
+)
+
+func NewGroupSuppressionResource() resource.Resource {
+	return &groupSuppressionResource{}
+}
+
+type groupSuppressionResource struct {
+	client *sendgrid.Client
+}
+
+type GroupSuppressionResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	GroupID    types.Int64  `tfsdk:"group_id"`
+	Emails     []string     `tfsdk:"emails"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *groupSuppressionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_suppression"
+}
+
+func (r *groupSuppressionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to unsubscribe emails from an unsubscribe group. Other emails unsubscribed from the group are left alone",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the unsubscribe group",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				Description: "The ID of the unsubscribe group",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"emails":       suppressedEmailsAttribute("The emails to unsubscribe from the group"),
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *groupSuppressionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GroupSuppressionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := clientFor(r.client, plan.OnBehalfOf).AddGroupSuppressions(ctx, plan.GroupID.ValueInt64(), plan.Emails)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error adding group suppressions", "Could not add group suppressions: ", err, "emails")
+		return
+	}
+
+	tflog.Debug(ctx, "Added group suppressions", map[string]any{"group_id": plan.GroupID.ValueInt64(), "count": len(plan.Emails)})
+
+	plan.ID = types.StringValue(strconv.FormatInt(plan.GroupID.ValueInt64(), 10))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read only refreshes the emails managed by the resource. After an import
+// no email is known yet and every email of the group is adopted.
+func (r *groupSuppressionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GroupSuppressionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, state.OnBehalfOf)
+	groupID := state.GroupID.ValueInt64()
+
+	var found []string
+	var err error
+	if len(state.Emails) == 0 {
+		found, err = client.ListGroupSuppressions(ctx, groupID)
+	} else {
+		found, err = client.SearchGroupSuppressions(ctx, groupID, state.Emails)
+		found = keepEmails(state.Emails, found)
+	}
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Unsubscribe group not found, removing from state", map[string]any{"group_id": groupID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading group suppressions",
+			fmt.Sprintf("Could not read suppressions of group %d: %s", groupID, err),
+		)
+		return
+	}
+
+	if len(found) == 0 {
+		tflog.Warn(ctx, "No managed email is unsubscribed from the group anymore, removing from state", map[string]any{"group_id": groupID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(strconv.FormatInt(groupID, 10))
+	state.Emails = found
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *groupSuppressionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state GroupSuppressionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, state.OnBehalfOf)
+	groupID := state.GroupID.ValueInt64()
+	added, removed := diffEmails(state.Emails, plan.Emails)
+
+	if len(added) > 0 {
+		if _, err := client.AddGroupSuppressions(ctx, groupID, added); err != nil {
+			addClientError(&resp.Diagnostics, "Error adding group suppressions", "Could not add group suppressions: ", err, "emails")
+			return
+		}
+	}
+
+	for _, email := range removed {
+		if _, err := client.DeleteGroupSuppression(ctx, groupID, email); err != nil && !sendgrid.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error removing group suppression",
+				fmt.Sprintf("Could not remove %s from group %d: %s", email, groupID, err),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Updated group suppressions", map[string]any{"group_id": groupID, "added": len(added), "removed": len(removed)})
+
+	plan.ID = state.ID
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *groupSuppressionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GroupSuppressionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, state.OnBehalfOf)
+	groupID := state.GroupID.ValueInt64()
+	for _, email := range state.Emails {
+		if _, err := client.DeleteGroupSuppression(ctx, groupID, email); err != nil {
+			if sendgrid.IsNotFound(err) {
+				continue
+			}
+
+			resp.Diagnostics.AddError(
+				"Error removing group suppression",
+				fmt.Sprintf("Could not remove %s from group %d: %s", email, groupID, err),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Removed group suppressions", map[string]any{"group_id": groupID, "count": len(state.Emails)})
+}
+
+func (r *groupSuppressionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState adopts every email unsubscribed from the group.
+func (r *groupSuppressionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	onBehalfOf, importID := splitOnBehalfOfImportID(req.ID)
+	groupID, err := strconv.ParseInt(importID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing group suppressions",
+			fmt.Sprintf("Unsubscribe group ID must be a number, got %q: %s", importID, err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
+}
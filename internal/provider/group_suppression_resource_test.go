@@ -0,0 +1,56 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupSuppressionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_unsubscribe_group" "test" {
+					name = "newsletter"
+				  }
+
+				resource "sendgrid_group_suppression" "test" {
+					group_id = sendgrid_unsubscribe_group.test.id
+					emails   = ["a@example.com", "b@example.com"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sendgrid_group_suppression.test", "group_id", "sendgrid_unsubscribe_group.test", "id"),
+					resource.TestCheckResourceAttr("sendgrid_group_suppression.test", "emails.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_group_suppression.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_unsubscribe_group" "test" {
+					name = "newsletter"
+				  }
+
+				resource "sendgrid_group_suppression" "test" {
+					group_id = sendgrid_unsubscribe_group.test.id
+					emails   = ["b@example.com", "c@example.com"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_group_suppression.test", "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_group_suppression.test", "emails.*", "c@example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
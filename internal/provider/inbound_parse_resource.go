@@ -0,0 +1,265 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &inboundParseResource{}
+	_ resource.ResourceWithConfigure   = &inboundParseResource{}
+	_ resource.ResourceWithImportState = &inboundParseResource{}
+)
+
+func NewInboundParseResource() resource.Resource {
+	return &inboundParseResource{}
+}
+
+type inboundParseResource struct {
+	client *sendgrid.Client
+}
+
+type InboundParseResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Hostname   types.String `tfsdk:"hostname"`
+	URL        types.String `tfsdk:"url"`
+	SpamCheck  types.Bool   `tfsdk:"spam_check"`
+	SendRaw    types.Bool   `tfsdk:"send_raw"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *inboundParseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inbound_parse"
+}
+
+func (r *inboundParseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage Inbound Parse settings, posting the emails received by a hostname to a URL",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The hostname of the setting",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Description: "The hostname receiving the emails. It must be an authenticated domain or one of its subdomains, and its MX record must point to mx.sendgrid.net",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL the parsed emails are posted to",
+				Required:    true,
+			},
+			"spam_check": schema.BoolAttribute{
+				Description: "Whether to check the emails for spam, the result is posted with the email. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"send_raw": schema.BoolAttribute{
+				Description: "Whether to post the full MIME message instead of the parsed fields. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func inboundParseState(parse *sendgrid.InboundParse, onBehalfOf types.String) InboundParseResourceModel {
+	return InboundParseResourceModel{
+		ID:         types.StringValue(parse.Hostname),
+		Hostname:   types.StringValue(parse.Hostname),
+		URL:        types.StringValue(parse.URL),
+		SpamCheck:  types.BoolValue(parse.SpamCheck),
+		SendRaw:    types.BoolValue(parse.SendRaw),
+		OnBehalfOf: onBehalfOf,
+	}
+}
+
+// authenticatedDomainOf returns the authenticated domain hostname belongs
+// to, the hostname itself or the closest parent domain.
+func authenticatedDomainOf(ctx context.Context, client *sendgrid.Client, hostname string) (*sendgrid.DomainAuth, error) {
+	for candidate := hostname; strings.Contains(candidate, "."); {
+		domain, err := client.GetDomainAuth(ctx, sendgrid.DomainAuth{Domain: candidate})
+		if err == nil {
+			return domain, nil
+		}
+		if !sendgrid.IsNotFound(err) {
+			return nil, err
+		}
+
+		_, candidate, _ = strings.Cut(candidate, ".")
+	}
+
+	return nil, fmt.Errorf("no authenticated domain found for %s: %w", hostname, sendgrid.ErrNotFound)
+}
+
+// Create checks the hostname against the authenticated domains first. This
+// is not done while planning, the domain may be created in the same apply.
+func (r *inboundParseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan InboundParseResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := clientFor(r.client, plan.OnBehalfOf)
+	hostname := plan.Hostname.ValueString()
+
+	domain, err := authenticatedDomainOf(ctx, client, hostname)
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hostname"),
+				"Hostname is not authenticated",
+				fmt.Sprintf("%s is neither an authenticated domain nor a subdomain of one. Authenticate the domain first, for example with sendgrid_domain_authentication.", hostname),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error looking up authenticated domain",
+			fmt.Sprintf("Could not look up the authenticated domain of %s: %s", hostname, err),
+		)
+		return
+	}
+	if !domain.Valid {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("hostname"),
+			"Authenticated domain is not validated",
+			fmt.Sprintf("The DNS records of %s are not validated yet, SendGrid may not receive emails for %s.", domain.Domain, hostname),
+		)
+	}
+
+	parse, err := client.CreateInboundParse(ctx, sendgrid.InboundParse{
+		Hostname:  hostname,
+		URL:       plan.URL.ValueString(),
+		SpamCheck: plan.SpamCheck.ValueBool(),
+		SendRaw:   plan.SendRaw.ValueBool(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating inbound parse setting", "Could not create inbound parse setting: ", err, "hostname", "url")
+		return
+	}
+
+	tflog.Debug(ctx, "Created inbound parse setting", map[string]any{"hostname": parse.Hostname})
+
+	diags = resp.State.Set(ctx, inboundParseState(parse, plan.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *inboundParseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state InboundParseResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parse, err := clientFor(r.client, state.OnBehalfOf).ReadInboundParse(ctx, state.ID.ValueString())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Inbound parse setting not found, removing from state", map[string]any{"hostname": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading inbound parse setting",
+			fmt.Sprintf("Could not read inbound parse setting %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, inboundParseState(parse, state.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *inboundParseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state InboundParseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parse, err := clientFor(r.client, state.OnBehalfOf).UpdateInboundParse(ctx, sendgrid.InboundParse{
+		Hostname:  state.ID.ValueString(),
+		URL:       plan.URL.ValueString(),
+		SpamCheck: plan.SpamCheck.ValueBool(),
+		SendRaw:   plan.SendRaw.ValueBool(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating inbound parse setting", "Could not update inbound parse setting: ", err, "url")
+		return
+	}
+
+	diags := resp.State.Set(ctx, inboundParseState(parse, state.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *inboundParseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state InboundParseResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := clientFor(r.client, state.OnBehalfOf).DeleteInboundParse(ctx, state.ID.ValueString())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting inbound parse setting",
+			fmt.Sprintf("Could not delete inbound parse setting %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted inbound parse setting", map[string]any{"hostname": state.ID.ValueString()})
+}
+
+func (r *inboundParseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *inboundParseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	onBehalfOf, hostname := splitOnBehalfOfImportID(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
+}
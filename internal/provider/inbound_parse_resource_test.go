@@ -0,0 +1,95 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+
+	sendgrid "terraform-provider-sendgrid/client"
+	"terraform-provider-sendgrid/internal/sendgridtest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAuthenticatedDomainOf(t *testing.T) {
+	srv := sendgridtest.NewServer()
+	defer srv.Close()
+
+	client, err := sendgrid.NewClient(sendgridtest.APIKey, sendgrid.WithBaseURL(srv.BaseURL()))
+	if err != nil {
+		t.Fatalf("NewClient: %s", err)
+	}
+	ctx := context.Background()
+
+	created, err := client.CreateDomainAuth(ctx, sendgrid.DomainAuth{Domain: "example.com"})
+	if err != nil {
+		t.Fatalf("CreateDomainAuth: %s", err)
+	}
+
+	for _, hostname := range []string{"example.com", "parse.example.com", "in.parse.example.com"} {
+		domain, err := authenticatedDomainOf(ctx, client, hostname)
+		if err != nil || domain.ID != created.ID {
+			t.Errorf("%s: unexpected domain %+v, %v", hostname, domain, err)
+		}
+	}
+
+	for _, hostname := range []string{"example.org", "parse.notexample.com"} {
+		if _, err := authenticatedDomainOf(ctx, client, hostname); !sendgrid.IsNotFound(err) {
+			t.Errorf("%s: expected not found, got %v", hostname, err)
+		}
+	}
+}
+
+func TestAccInboundParseResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_domain_authentication" "test" {
+					environment = "nonprod"
+					domain      = "example.com"
+				  }
+
+				resource "sendgrid_inbound_parse" "test" {
+					hostname   = "parse.${sendgrid_domain_authentication.test.domain}"
+					url        = "https://hooks.example.com/parse"
+					spam_check = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_inbound_parse.test", "id", "parse.example.com"),
+					resource.TestCheckResourceAttr("sendgrid_inbound_parse.test", "spam_check", "true"),
+					resource.TestCheckResourceAttr("sendgrid_inbound_parse.test", "send_raw", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_inbound_parse.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_domain_authentication" "test" {
+					environment = "nonprod"
+					domain      = "example.com"
+				  }
+
+				resource "sendgrid_inbound_parse" "test" {
+					hostname = "parse.${sendgrid_domain_authentication.test.domain}"
+					url      = "https://hooks.example.com/raw"
+					send_raw = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_inbound_parse.test", "url", "https://hooks.example.com/raw"),
+					resource.TestCheckResourceAttr("sendgrid_inbound_parse.test", "spam_check", "false"),
+					resource.TestCheckResourceAttr("sendgrid_inbound_parse.test", "send_raw", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
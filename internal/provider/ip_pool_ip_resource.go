@@ -0,0 +1,184 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ipPoolIPResource{}
+	_ resource.ResourceWithConfigure   = &ipPoolIPResource{}
+	_ resource.ResourceWithImportState = &ipPoolIPResource{}
+)
+
+func NewIPPoolIPResource() resource.Resource {
+	return &ipPoolIPResource{}
+}
+
+type ipPoolIPResource struct {
+	client *sendgrid.Client
+}
+
+type IPPoolIPResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	PoolName types.String `tfsdk:"pool_name"`
+	IP       types.String `tfsdk:"ip"`
+}
+
+func (r *ipPoolIPResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_pool_ip"
+}
+
+func (r *ipPoolIPResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to add a dedicated IP to an IP pool. An IP can be in several pools",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The pool name and the IP separated by a comma",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pool_name": schema.StringAttribute{
+				Description: "The name of the pool",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Description: "The IP to add to the pool. It must be a dedicated IP of the account",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func ipPoolIPID(poolName, ip string) string {
+	return poolName + "," + ip
+}
+
+func (r *ipPoolIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IPPoolIPResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AddIPToPool(ctx, plan.PoolName.ValueString(), plan.IP.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error adding IP to pool", "Could not add IP to pool: ", err, "ip")
+		return
+	}
+
+	tflog.Debug(ctx, "Added IP to pool", map[string]any{"pool_name": plan.PoolName.ValueString(), "ip": plan.IP.ValueString()})
+
+	plan.ID = types.StringValue(ipPoolIPID(plan.PoolName.ValueString(), plan.IP.ValueString()))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ipPoolIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IPPoolIPResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.ReadIPPool(ctx, state.PoolName.ValueString())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error reading IP pool",
+			fmt.Sprintf("Could not read IP pool %s: %s", state.PoolName.ValueString(), err),
+		)
+		return
+	}
+
+	if err == nil {
+		for _, ip := range pool.IPs {
+			if ip.IP == state.IP.ValueString() {
+				return
+			}
+		}
+	}
+
+	tflog.Warn(ctx, "IP not found in pool, removing from state", map[string]any{"id": state.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+// Update is never called, changing any attribute replaces the resource.
+func (r *ipPoolIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *ipPoolIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IPPoolIPResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.RemoveIPFromPool(ctx, state.PoolName.ValueString(), state.IP.ValueString())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error removing IP from pool",
+			fmt.Sprintf("Could not remove IP %s from pool %s: %s", state.IP.ValueString(), state.PoolName.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Removed IP from pool", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *ipPoolIPResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Parent()
+}
+
+// ImportState takes an ID of the form <pool_name>,<ip>. Pool names may
+// contain commas, IPs do not.
+func (r *ipPoolIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	i := strings.LastIndex(req.ID, ",")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Error importing IP pool IP",
+			fmt.Sprintf("Expected an ID of the form <pool_name>,<ip>, got %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pool_name"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), req.ID[i+1:])...)
+}
@@ -0,0 +1,55 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The IPs are dedicated IPs of the account of the fake, see
+// sendgridtest.DedicatedIPs.
+func TestAccIPPoolIPResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_ip_pool" "test" {
+					name = "transactional"
+				  }
+
+				resource "sendgrid_ip_pool_ip" "test" {
+					pool_name = sendgrid_ip_pool.test.name
+					ip        = "192.0.2.1"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool_ip.test", "id", "transactional,192.0.2.1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_ip_pool_ip.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_ip_pool" "test" {
+					name = "transactional"
+				  }
+
+				resource "sendgrid_ip_pool_ip" "test" {
+					pool_name = sendgrid_ip_pool.test.name
+					ip        = "192.0.2.2"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool_ip.test", "id", "transactional,192.0.2.2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ipPoolResource{}
+	_ resource.ResourceWithConfigure   = &ipPoolResource{}
+	_ resource.ResourceWithImportState = &ipPoolResource{}
+)
+
+func NewIPPoolResource() resource.Resource {
+	return &ipPoolResource{}
+}
+
+type ipPoolResource struct {
+	client *sendgrid.Client
+}
+
+type IPPoolResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *ipPoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_pool"
+}
+
+func (r *ipPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage a pool of dedicated IPs. IPs are added to the pool with sendgrid_ip_pool_ip",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the pool",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the pool, used as ip_pool_name when sending. Renaming keeps the IPs in the pool",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+		},
+	}
+}
+
+func ipPoolState(pool *sendgrid.IPPool) IPPoolResourceModel {
+	return IPPoolResourceModel{
+		ID:   types.StringValue(pool.Name),
+		Name: types.StringValue(pool.Name),
+	}
+}
+
+func (r *ipPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IPPoolResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.CreateIPPool(ctx, plan.Name.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating IP pool", "Could not create IP pool: ", err, "name")
+		return
+	}
+
+	tflog.Debug(ctx, "Created IP pool", map[string]any{"name": pool.Name})
+
+	diags = resp.State.Set(ctx, ipPoolState(pool))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ipPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IPPoolResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.ReadIPPool(ctx, state.ID.ValueString())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "IP pool not found, removing from state", map[string]any{"name": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading IP pool",
+			fmt.Sprintf("Could not read IP pool %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, ipPoolState(pool))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ipPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state IPPoolResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.RenameIPPool(ctx, state.ID.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error renaming IP pool", "Could not rename IP pool: ", err, "name")
+		return
+	}
+
+	diags := resp.State.Set(ctx, ipPoolState(pool))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ipPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IPPoolResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteIPPool(ctx, state.ID.ValueString())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting IP pool",
+			fmt.Sprintf("Could not delete IP pool %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted IP pool", map[string]any{"name": state.ID.ValueString()})
+}
+
+func (r *ipPoolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Parent()
+}
+
+func (r *ipPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,44 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIPPoolResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_ip_pool" "test" {
+					name = "marketing"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.test", "id", "marketing"),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.test", "name", "marketing"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_ip_pool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_ip_pool" "test" {
+					name = "newsletters"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.test", "id", "newsletters"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,228 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ipWarmupResource{}
+	_ resource.ResourceWithConfigure   = &ipWarmupResource{}
+	_ resource.ResourceWithImportState = &ipWarmupResource{}
+)
+
+func NewIPWarmupResource() resource.Resource {
+	return &ipWarmupResource{}
+}
+
+type ipWarmupResource struct {
+	client *sendgrid.Client
+}
+
+type IPWarmupResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IP        types.String `tfsdk:"ip"`
+	StartDate types.Int64  `tfsdk:"start_date"`
+	InWarmup  types.Bool   `tfsdk:"in_warmup"`
+}
+
+func (r *ipWarmupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_warmup"
+}
+
+func (r *ipWarmupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to warm up a dedicated IP. Creating the resource starts the warmup, destroying it stops the warmup. Once SendGrid ends the warmup the resource stays in state with in_warmup set to false, the warmup is not started again",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The IP in warmup",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Description: "The dedicated IP to warm up. It must be an IP of the account",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_date": schema.Int64Attribute{
+				Description: "The unix time the warmup started",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"in_warmup": schema.BoolAttribute{
+				Description: "Whether the IP is still in warmup. It turns false once SendGrid ends the warmup",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ipWarmupState(warmup *sendgrid.IPWarmup) IPWarmupResourceModel {
+	return IPWarmupResourceModel{
+		ID:        types.StringValue(warmup.IP),
+		IP:        types.StringValue(warmup.IP),
+		StartDate: types.Int64Value(warmup.StartDate),
+		InWarmup:  types.BoolValue(true),
+	}
+}
+
+func (r *ipWarmupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IPWarmupResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip := plan.IP.ValueString()
+
+	// starting the warmup of an unknown IP fails with a bare 404, look the
+	// IP up first to tell what is wrong
+	_, err := r.client.ReadIP(ctx, ip)
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ip"),
+				"IP not found",
+				fmt.Sprintf("%s is not an IP of the account, see the sendgrid_ips data source for the IPs of the account.", ip),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error looking up IP",
+			fmt.Sprintf("Could not look up IP %s: %s", ip, err),
+		)
+		return
+	}
+
+	warmup, err := r.client.StartIPWarmup(ctx, ip)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error starting IP warmup", "Could not start IP warmup: ", err, "ip")
+		return
+	}
+
+	tflog.Debug(ctx, "Started IP warmup", map[string]any{"ip": warmup.IP})
+
+	diags = resp.State.Set(ctx, ipWarmupState(warmup))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ipWarmupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IPWarmupResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warmup, err := r.client.ReadIPWarmup(ctx, state.ID.ValueString())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			r.readFinished(ctx, state, resp)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading IP warmup",
+			fmt.Sprintf("Could not read the warmup of IP %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, ipWarmupState(warmup))
+	resp.Diagnostics.Append(diags...)
+}
+
+// readFinished keeps an IP that is no longer in warmup in state, SendGrid
+// ends the warmup on its own and recreating the resource would start it
+// again. The resource is only removed when the IP left the account.
+func (r *ipWarmupResource) readFinished(ctx context.Context, state IPWarmupResourceModel, resp *resource.ReadResponse) {
+	_, err := r.client.ReadIP(ctx, state.ID.ValueString())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "IP not found, removing from state", map[string]any{"ip": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading IP warmup",
+			fmt.Sprintf("Could not look up IP %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "IP no longer in warmup", map[string]any{"ip": state.ID.ValueString()})
+
+	state.IP = state.ID
+	state.InWarmup = types.BoolValue(false)
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called, changing the IP replaces the resource.
+func (r *ipWarmupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *ipWarmupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IPWarmupResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.StopIPWarmup(ctx, state.ID.ValueString())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error stopping IP warmup",
+			fmt.Sprintf("Could not stop the warmup of IP %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Stopped IP warmup", map[string]any{"ip": state.ID.ValueString()})
+}
+
+func (r *ipWarmupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Parent()
+}
+
+func (r *ipWarmupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
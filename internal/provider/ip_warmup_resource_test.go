@@ -0,0 +1,88 @@
+package sendgrid
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The IP is a dedicated IP of the account of the fake, see
+// sendgridtest.DedicatedIPs.
+func TestAccIPWarmupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown IP testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_ip_warmup" "test" {
+					ip = "198.51.100.1"
+				  }
+`,
+				ExpectError: regexp.MustCompile("not an IP of the account"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_ip_warmup" "test" {
+					ip = "192.0.2.2"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "id", "192.0.2.2"),
+					resource.TestCheckResourceAttrSet("sendgrid_ip_warmup.test", "start_date"),
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "in_warmup", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_ip_warmup.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccIPWarmupResourceFinished needs the fake to end the warmup, which
+// takes weeks on a live account.
+func TestAccIPWarmupResourceFinished(t *testing.T) {
+	if testAccServer == nil {
+		t.Skip("requires the fake SendGrid API")
+	}
+
+	config := providerConfig + `
+				resource "sendgrid_ip_warmup" "test" {
+					ip = "192.0.2.3"
+				  }
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "in_warmup", "true"),
+				),
+			},
+			// The finished warmup stays in state and is not started again
+			{
+				PreConfig: func() {
+					if !testAccServer.FinishIPWarmup("192.0.2.3") {
+						t.Fatal("192.0.2.3 is not in warmup")
+					}
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "id", "192.0.2.3"),
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "in_warmup", "false"),
+					resource.TestCheckResourceAttrSet("sendgrid_ip_warmup.test", "start_date"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
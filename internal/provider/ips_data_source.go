@@ -0,0 +1,201 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ipsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ipsDataSource{}
+)
+
+func NewIPsDataSource() datasource.DataSource {
+	return &ipsDataSource{}
+}
+
+type ipsDataSource struct {
+	client *sendgrid.Client
+}
+
+type DataIPsModel struct {
+	AssignedOnly types.Bool    `tfsdk:"assigned_only"`
+	IPs          []DataIPModel `tfsdk:"ips"`
+}
+
+type DataIPModel struct {
+	IP           types.String `tfsdk:"ip"`
+	Assigned     types.Bool   `tfsdk:"assigned"`
+	Pools        []string     `tfsdk:"pools"`
+	Subusers     []string     `tfsdk:"subusers"`
+	Warmup       types.Bool   `tfsdk:"warmup"`
+	StartDate    types.Int64  `tfsdk:"start_date"`
+	Rdns         types.String `tfsdk:"rdns"`
+	Whitelabeled types.Bool   `tfsdk:"whitelabeled"`
+}
+
+func (d *ipsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ips"
+}
+
+func (d *ipsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Parent()
+}
+
+func (d *ipsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the IPs of the account with their pools, warmup state and subusers",
+		Attributes: map[string]schema.Attribute{
+			"assigned_only": schema.BoolAttribute{
+				Description: "Only list the IPs assigned to the account, leaving out IPs that were bought but are not in use yet",
+				Optional:    true,
+			},
+			"ips": schema.ListNestedAttribute{
+				Description: "The IPs of the account, sorted by address",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							Description: "The IP address",
+							Computed:    true,
+						},
+						"assigned": schema.BoolAttribute{
+							Description: "Whether the IP is assigned to the account",
+							Computed:    true,
+						},
+						"pools": schema.ListAttribute{
+							Description: "The names of the IP pools the IP is in",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"subusers": schema.ListAttribute{
+							Description: "The usernames of the subusers that can send from the IP",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"warmup": schema.BoolAttribute{
+							Description: "Whether the IP is being warmed up",
+							Computed:    true,
+						},
+						"start_date": schema.Int64Attribute{
+							Description: "The unix time warmup started, 0 when the IP is not being warmed up",
+							Computed:    true,
+						},
+						"rdns": schema.StringAttribute{
+							Description: "The reverse DNS record of the IP, empty unless set up",
+							Computed:    true,
+						},
+						"whitelabeled": schema.BoolAttribute{
+							Description: "Whether the IP has a reverse DNS record",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ipsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DataIPsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ips, err := d.client.ListIPs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing IPs",
+			"Error listing IPs: "+err.Error(),
+		)
+		return
+	}
+
+	// /ips also lists IPs not yet assigned to the account, /ips/assigned
+	// tells them apart
+	assignedIPs, err := d.client.ListAssignedIPs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing assigned IPs",
+			"Error listing assigned IPs: "+err.Error(),
+		)
+		return
+	}
+	assigned := map[string]bool{}
+	for _, ip := range assignedIPs {
+		assigned[ip.IP] = true
+	}
+
+	config.IPs = []DataIPModel{}
+	for _, ip := range ips {
+		if config.AssignedOnly.ValueBool() && !assigned[ip.IP] {
+			continue
+		}
+
+		pools, subusers := ip.Pools, ip.Subusers
+		if pools == nil {
+			pools = []string{}
+		}
+		if subusers == nil {
+			subusers = []string{}
+		}
+
+		config.IPs = append(config.IPs, DataIPModel{
+			IP:           types.StringValue(ip.IP),
+			Assigned:     types.BoolValue(assigned[ip.IP]),
+			Pools:        pools,
+			Subusers:     subusers,
+			Warmup:       types.BoolValue(ip.Warmup),
+			StartDate:    types.Int64Value(ip.StartDate),
+			Rdns:         types.StringValue(ip.Rdns),
+			Whitelabeled: types.BoolValue(ip.Whitelabeled),
+		})
+	}
+
+	sort.Slice(config.IPs, func(i, j int) bool {
+		return compareIPs(config.IPs[i].IP.ValueString(), config.IPs[j].IP.ValueString()) < 0
+	})
+
+	diags := resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// compareIPs orders IPs by address, IPv4 before IPv6. Values that cannot be
+// parsed sort after the valid ones, by string.
+func compareIPs(a, b string) int {
+	addrA, errA := netip.ParseAddr(a)
+	addrB, errB := netip.ParseAddr(b)
+	switch {
+	case errA == nil && errB == nil:
+		return addrA.Compare(addrB)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
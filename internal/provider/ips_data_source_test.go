@@ -0,0 +1,60 @@
+package sendgrid
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The fake account has five IPs, 192.0.2.4 is not assigned and 192.0.2.10 is
+// listed before 192.0.2.2, see sendgridtest.DedicatedIPs.
+func TestAccIPsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "sendgrid_ip_pool" "test" {
+					name = "ips"
+				  }
+
+				resource "sendgrid_ip_pool_ip" "test" {
+					pool_name = sendgrid_ip_pool.test.name
+					ip        = "192.0.2.3"
+				  }
+
+				data "sendgrid_ips" "all" {
+					depends_on = [sendgrid_ip_pool_ip.test]
+				  }
+
+				data "sendgrid_ips" "assigned" {
+					assigned_only = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_ips.all", "ips.#", "5"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.all", "ips.1.ip", "192.0.2.2"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.all", "ips.2.ip", "192.0.2.3"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.all", "ips.2.pools.0", "ips"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.all", "ips.3.ip", "192.0.2.4"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.all", "ips.3.assigned", "false"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.all", "ips.4.ip", "192.0.2.10"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.assigned", "ips.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestCompareIPs(t *testing.T) {
+	ips := []string{"2001:db8::1", "invalid", "192.0.2.10", "10.0.0.1", "192.0.2.2"}
+	sort.Slice(ips, func(i, j int) bool { return compareIPs(ips[i], ips[j]) < 0 })
+
+	want := []string{"10.0.0.1", "192.0.2.2", "192.0.2.10", "2001:db8::1", "invalid"}
+	for i := range want {
+		if ips[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, ips)
+		}
+	}
+}
@@ -55,7 +55,7 @@ func (d *ipwhitelistDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 
 // Read refreshes the Terraform state with the latest data.
 func (d *ipwhitelistDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var refstate IpwhitelistModel
+	var refstate IpwhitelistDataModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &refstate)...)
 
@@ -69,7 +69,7 @@ func (d *ipwhitelistDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	refstate = IpwhitelistModel{
+	refstate = IpwhitelistDataModel{
 		Ip: types.StringValue(itemResponse.Result.IP),
 		Id: types.Int64Value(itemResponse.Result.ID),
 	}
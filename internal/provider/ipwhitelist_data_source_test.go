@@ -10,45 +10,24 @@ func TestAccipwhitelistDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Create and Read testing
+			// Read testing
 			{
 				Config: providerConfig + `
-				data "sendgrid_ipwhitelist" "test" {
-					id = 19050029
+				resource "sendgrid_ipwhitelist" "test" {
+					ip = "185.69.116.108/32"
 				  }
-`,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify number of items
-					// Verify first order item
-					resource.TestCheckResourceAttr("sendgrid_ipwhitelist.test", "ip", "185.69.116.108/32"),
-					// Verify dynamic values have any value set in the state.
-					resource.TestCheckResourceAttrSet("sendgrid_ipwhitelist.test", "id"),
-				),
-			},
-			// ImportState testing
-			{
-				ResourceName:      "sendgrid_ipwhitelist.test",
-				ImportState:       true,
-				ImportStateVerify: true,
-				// The last_updated attribute does not exist in the sendgrid
-				// API, therefore there is no value for it during import.
-				//				ImportStateVerifyIgnore: []string{"last_updated"},
-			},
-			// Update and Read testing
-			{
-				Config: providerConfig + `
+
 				data "sendgrid_ipwhitelist" "test" {
-					id = 19050029
+					id = sendgrid_ipwhitelist.test.id
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify first order item updated
-					resource.TestCheckResourceAttr("sendgrid_ipwhitelist.test", "ip", "185.69.116.108/32"),
+					// Verify first order item
+					resource.TestCheckResourceAttr("data.sendgrid_ipwhitelist.test", "ip", "185.69.116.108/32"),
 					// Verify dynamic values have any value set in the state.
-					resource.TestCheckResourceAttrSet("sendgrid_ipwhitelist.test", "id"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_ipwhitelist.test", "id", "sendgrid_ipwhitelist.test", "id"),
 				),
 			},
-			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
@@ -28,8 +28,9 @@ type ipwhitelistResource struct {
 }
 
 type IpwhitelistModel struct {
-	Ip types.String `tfsdk:"ip"`
-	Id types.Int64  `tfsdk:"id"`
+	Ip         types.String `tfsdk:"ip"`
+	Id         types.Int64  `tfsdk:"id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
 }
 
 func (r *ipwhitelistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +52,7 @@ func (r *ipwhitelistResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "ID of the IP address",
 				Computed:    true,
 			},
+			"on_behalf_of": onBehalfOfAttribute(),
 		},
 	}
 }
@@ -71,19 +73,17 @@ func (r *ipwhitelistResource) Create(ctx context.Context, req resource.CreateReq
 		IP: ip,
 	}
 
-	ipwlressponse, err := r.client.CreateIPMgmt(ctx, item)
+	ipwlressponse, err := clientFor(r.client, state.OnBehalfOf).CreateIPMgmt(ctx, item)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating ip whitelist",
-			fmt.Sprintf("Error creating ip whitelist: %s", err.Error()),
-		)
+		addClientError(&resp.Diagnostics, "Error creating ip whitelist", "Error creating ip whitelist: ", err, "ip")
 
 		return
 	}
 
 	state = IpwhitelistModel{
-		Ip: types.StringValue(ipwlressponse.IP),
-		Id: types.Int64Value(ipwlressponse.ID),
+		Ip:         types.StringValue(ipwlressponse.IP),
+		Id:         types.Int64Value(ipwlressponse.ID),
+		OnBehalfOf: state.OnBehalfOf,
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -119,8 +119,14 @@ func (r *ipwhitelistResource) Read(ctx context.Context, req resource.ReadRequest
 
 	tflog.Debug(ctx, "Callme to read", map[string]any{"ID: %s": inputItem})
 
-	ipwlgetlist, err := r.client.GetIPMgmt(ctx, inputItem)
+	ipwlgetlist, err := clientFor(r.client, refstate.OnBehalfOf).GetIPMgmt(ctx, inputItem)
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "IP whitelist entry not found, removing from state", map[string]any{"id": inputItem})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error reading ip whitelist",
 			fmt.Sprintf("Error reading ip whitelist: %s", err.Error()),
@@ -130,8 +136,9 @@ func (r *ipwhitelistResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	refstate = IpwhitelistModel{
-		Ip: types.StringValue(ipwlgetlist.Result.IP),
-		Id: types.Int64Value(ipwlgetlist.Result.ID),
+		Ip:         types.StringValue(ipwlgetlist.Result.IP),
+		Id:         types.Int64Value(ipwlgetlist.Result.ID),
+		OnBehalfOf: refstate.OnBehalfOf,
 	}
 
 	diags = resp.State.Set(ctx, refstate)
@@ -158,7 +165,7 @@ func (r *ipwhitelistResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	tflog.Debug(ctx, "Item to be deleted", map[string]any{"ID: %+v": delema})
 
-	_, err := r.client.DeleteIPMgmt(ctx, fmt.Sprintf("%d", delema.Id.ValueInt64()))
+	_, err := clientFor(r.client, delema.OnBehalfOf).DeleteIPMgmt(ctx, fmt.Sprintf("%d", delema.Id.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting ip whitelist",
@@ -204,6 +211,8 @@ func (r *ipwhitelistResource) ImportState(ctx context.Context, req resource.Impo
 	// }
 	// resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 
-	resource.ImportStatePassthroughID(ctx, path.Root("ip"), req, resp)
+	onBehalfOf, ip := splitOnBehalfOfImportID(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), ip)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
 
 }
@@ -118,6 +118,12 @@ func (r *linkbrandvalidateResource) Read(ctx context.Context, req resource.ReadR
 
 	linkreaditem, err := r.client.Getlinkbrand(ctx, sendgrid.LinkAuth{ID: linkreadstate.ID.ValueInt64()})
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Link branding not found, removing from state", map[string]any{"id": linkreadstate.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error reading link branding",
 			fmt.Sprintf("Error reading link branding: %s", err),
@@ -26,6 +26,19 @@ type linkbrandDataSource struct {
 	client *sendgrid.Client
 }
 
+type DataLinkbrandModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Domain        types.String `tfsdk:"domain"`
+	Subdomain     types.String `tfsdk:"subdomain"`
+	Username      types.String `tfsdk:"username"`
+	UserId        types.Int64  `tfsdk:"user_id"`
+	Defaultdomain types.Bool   `tfsdk:"default"`
+	Valid         types.Bool   `tfsdk:"valid"`
+	Legacy        types.Bool   `tfsdk:"legacy"`
+	DCNAME        types.Object `tfsdk:"domain_cname"`
+	OCNAME        types.Object `tfsdk:"owner_cname"`
+}
+
 func (d *linkbrandDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_linkbrand"
 }
@@ -132,7 +145,7 @@ func (d *linkbrandDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *linkbrandDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var datalinkbrand LinkbrandResourceModel
+	var datalinkbrand DataLinkbrandModel
 
 	diags := req.Config.Get(ctx, &datalinkbrand)
 	resp.Diagnostics.Append(diags...)
@@ -203,7 +216,7 @@ func (d *linkbrandDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	datalinkbrand = LinkbrandResourceModel{
+	datalinkbrand = DataLinkbrandModel{
 		ID:            types.Int64Value(getlinkbranditem.ID),
 		UserId:        types.Int64Value(getlinkbranditem.UserId),
 		Domain:        types.StringValue(getlinkbranditem.Domain),
@@ -10,55 +10,29 @@ func TestAcclinkbrandingDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Create and Read testing
+			// Read testing
 			{
 				Config: providerConfig + `
+				resource "sendgrid_linkbrand" "test" {
+					domain    = "data.example.com"
+					subdomain = "url09em21"
+				  }
+
 				data "sendgrid_linkbrand" "test" {
-					id = 123456789
+					id = sendgrid_linkbrand.test.id
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("sendgrid_linkbrand.test", "id"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "domain", "example.com"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "subdomain", "url091234"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "default", "false"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "valid", "true"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "legacy", "false"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "user_id", "1234567"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "username", "testuser"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "domain_cname", "{\"data\":\"sendgrid.net\",\"valid\":true,\"host\":\"url091234.example.com\",\"types\":\"cname\"}"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "owner_cname", "{\"data\":\"sendgrid.net\",\"valid\":true,\"host\":\"url091234.example.com\",\"types\":\"cname\"}"),
-				),
-			},
-			// ImportState testing
-			{
-				ResourceName:      "sendgrid_linkbrand.test",
-				ImportState:       true,
-				ImportStateVerify: true,
-				// The last_updated attribute does not exist in the sendgrid
-				// API, therefore there is no value for it during import.
-				//				ImportStateVerifyIgnore: []string{"last_updated"},
-			},
-			// Update and Read testing
-			{
-				Config: providerConfig + `
-				resource "sendgrid_link_branding" "name" {
-					domain = "example.com"
-  					subdomain = "url09em21"
-  					default = false
-				  }
-				`,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("sendgrid_linkbrand.test", "id"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "domain", "example.com"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "subdomain", "url091234"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "default", "false"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "valid", "true"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "legacy", "false"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "user_id", "1234567"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "username", "testuser"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "domain_cname", "{\"data\":\"sendgrid.net\",\"valid\":true,\"host\":\"url091234.example.com\",\"types\":\"cname\"}"),
-					resource.TestCheckResourceAttr("sendgrid_linkbrand.test", "owner_cname", "{\"data\":\"sendgrid.net\",\"valid\":true,\"host\":\"url091234.example.com\",\"types\":\"cname\"}"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_linkbrand.test", "id", "sendgrid_linkbrand.test", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_linkbrand.test", "domain", "data.example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_linkbrand.test", "subdomain", "url09em21"),
+					resource.TestCheckResourceAttr("data.sendgrid_linkbrand.test", "default", "false"),
+					resource.TestCheckResourceAttr("data.sendgrid_linkbrand.test", "valid", "false"),
+					resource.TestCheckResourceAttr("data.sendgrid_linkbrand.test", "legacy", "false"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_linkbrand.test", "user_id", "sendgrid_linkbrand.test", "user_id"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_linkbrand.test", "username", "sendgrid_linkbrand.test", "username"),
+					resource.TestCheckResourceAttr("data.sendgrid_linkbrand.test", "domain_cname.host", "url09em21.data.example.com"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_linkbrand.test", "owner_cname.host", "sendgrid_linkbrand.test", "owner_cname.host"),
 				),
 			},
 		},
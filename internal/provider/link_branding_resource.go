@@ -47,6 +47,7 @@ type LinkbrandResourceModel struct {
 	Legacy        types.Bool   `tfsdk:"legacy"`
 	DCNAME        types.Object `tfsdk:"domain_cname"`
 	OCNAME        types.Object `tfsdk:"owner_cname"`
+	OnBehalfOf    types.String `tfsdk:"on_behalf_of"`
 }
 
 func (r *linkbrandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -184,6 +185,7 @@ func (r *linkbrandResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					objectplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"on_behalf_of": onBehalfOfAttribute(),
 		},
 	}
 }
@@ -215,12 +217,9 @@ func (r *linkbrandResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	newItem, err := r.client.CreateLinkBrand(ctx, itemState)
+	newItem, err := clientFor(r.client, newstate.OnBehalfOf).CreateLinkBrand(ctx, itemState)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating link branding",
-			fmt.Sprintf("Error creating link branding: %s", err),
-		)
+		addClientError(&resp.Diagnostics, "Error creating link branding", "Error creating link branding: ", err, "domain", "subdomain", "default")
 		return
 	}
 
@@ -287,6 +286,7 @@ func (r *linkbrandResource) Create(ctx context.Context, req resource.CreateReque
 		Valid:         types.BoolValue(newItem.Valid),
 		DCNAME:        dcnameMapVlaue,
 		OCNAME:        ocnameMapVlaue,
+		OnBehalfOf:    newstate.OnBehalfOf,
 	}
 
 	//getelemements := make(map[string]DomainAuthRecord)
@@ -311,8 +311,14 @@ func (r *linkbrandResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	readitem, err := r.client.Getlinkbrand(ctx, sendgrid.LinkAuth{ID: readstate.ID.ValueInt64()})
+	readitem, err := clientFor(r.client, readstate.OnBehalfOf).Getlinkbrand(ctx, sendgrid.LinkAuth{ID: readstate.ID.ValueInt64()})
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Link branding not found, removing from state", map[string]any{"id": readstate.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error reading link branding",
 			fmt.Sprintf("Error reading link branding: %s", err),
@@ -381,6 +387,7 @@ func (r *linkbrandResource) Read(ctx context.Context, req resource.ReadRequest,
 		Valid:         types.BoolValue(readitem.Valid),
 		DCNAME:        readcnameMapVlaue,
 		OCNAME:        readocnameMapVlaue,
+		OnBehalfOf:    readstate.OnBehalfOf,
 	}
 
 	diags = resp.State.Set(ctx, readstate)
@@ -411,12 +418,9 @@ func (r *linkbrandResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	if updateplan.Defaultdomain != updatestate.Defaultdomain {
-		updaterespBody, err := r.client.Updatelinkbrand(ctx, itemState)
+		updaterespBody, err := clientFor(r.client, updatestate.OnBehalfOf).Updatelinkbrand(ctx, itemState)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating link branding",
-				fmt.Sprintf("Error validating link brand: %s", err),
-			)
+			addClientError(&resp.Diagnostics, "Error updating link branding", "Error validating link brand: ", err, "default")
 			return
 		}
 
@@ -482,6 +486,7 @@ func (r *linkbrandResource) Update(ctx context.Context, req resource.UpdateReque
 				Valid:         types.BoolValue(updaterespBody.Valid),
 				DCNAME:        valdcnameMapVlaue,
 				OCNAME:        valocnameMapVlaue,
+				OnBehalfOf:    updatestate.OnBehalfOf,
 			}
 
 			resp.Diagnostics.Append(resp.State.Set(ctx, updateplan)...)
@@ -515,7 +520,7 @@ func (r *linkbrandResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	_, err := r.client.Deletelinkbrand(ctx, fmt.Sprintf("%d", deletestate.ID.ValueInt64()))
+	_, err := clientFor(r.client, deletestate.OnBehalfOf).Deletelinkbrand(ctx, fmt.Sprintf("%d", deletestate.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting link branding",
@@ -548,7 +553,8 @@ func (r *linkbrandResource) Configure(_ context.Context, req resource.ConfigureR
 }
 
 func (r *linkbrandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
+	onBehalfOf, importID := splitOnBehalfOfImportID(req.ID)
+	id, err := strconv.ParseInt(importID, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing Link Branding",
@@ -558,4 +564,5 @@ func (r *linkbrandResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
 }
@@ -0,0 +1,325 @@
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MailSettingBCCResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Email      types.String `tfsdk:"email"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func NewMailSettingBCCResource() resource.Resource {
+	const name = "mail_settings_bcc"
+
+	state := func(setting *sendgrid.MailSettingBCC, prior MailSettingBCCResourceModel) MailSettingBCCResourceModel {
+		return MailSettingBCCResourceModel{
+			ID:         types.StringValue(name),
+			Enabled:    types.BoolValue(setting.Enabled),
+			Email:      types.StringValue(setting.Email),
+			OnBehalfOf: prior.OnBehalfOf,
+		}
+	}
+
+	return &settingResource[MailSettingBCCResourceModel]{
+		name:        name,
+		description: "Resource to send a blind carbon copy of every email to an address",
+		attributes: map[string]schema.Attribute{
+			"enabled": settingEnabledAttribute(),
+			"email": schema.StringAttribute{
+				Description: "The address receiving the copies",
+				Required:    true,
+			},
+		},
+		read: func(ctx context.Context, client *sendgrid.Client, prior MailSettingBCCResourceModel) (MailSettingBCCResourceModel, error) {
+			setting, err := client.ReadMailSettingBCC(ctx)
+			if err != nil {
+				return prior, err
+			}
+			return state(setting, prior), nil
+		},
+		update: func(ctx context.Context, client *sendgrid.Client, plan MailSettingBCCResourceModel) (MailSettingBCCResourceModel, error) {
+			setting, err := client.UpdateMailSettingBCC(ctx, sendgrid.MailSettingBCC{
+				Enabled: plan.Enabled.ValueBool(),
+				Email:   plan.Email.ValueString(),
+			})
+			if err != nil {
+				return plan, err
+			}
+			return state(setting, plan), nil
+		},
+		disable: func(ctx context.Context, client *sendgrid.Client) error {
+			_, err := client.UpdateMailSettingBCC(ctx, sendgrid.MailSettingBCC{})
+			return err
+		},
+	}
+}
+
+type MailSettingFooterResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	HTMLContent  types.String `tfsdk:"html_content"`
+	PlainContent types.String `tfsdk:"plain_content"`
+	OnBehalfOf   types.String `tfsdk:"on_behalf_of"`
+}
+
+func NewMailSettingFooterResource() resource.Resource {
+	const name = "mail_settings_footer"
+
+	state := func(setting *sendgrid.MailSettingFooter, prior MailSettingFooterResourceModel) MailSettingFooterResourceModel {
+		return MailSettingFooterResourceModel{
+			ID:           types.StringValue(name),
+			Enabled:      types.BoolValue(setting.Enabled),
+			HTMLContent:  types.StringValue(setting.HTMLContent),
+			PlainContent: types.StringValue(setting.PlainContent),
+			OnBehalfOf:   prior.OnBehalfOf,
+		}
+	}
+
+	return &settingResource[MailSettingFooterResourceModel]{
+		name:        name,
+		description: "Resource to append a footer to every email",
+		attributes: map[string]schema.Attribute{
+			"enabled":       settingEnabledAttribute(),
+			"html_content":  settingStringAttribute("The footer appended to the HTML content of emails"),
+			"plain_content": settingStringAttribute("The footer appended to the plain text content of emails"),
+		},
+		read: func(ctx context.Context, client *sendgrid.Client, prior MailSettingFooterResourceModel) (MailSettingFooterResourceModel, error) {
+			setting, err := client.ReadMailSettingFooter(ctx)
+			if err != nil {
+				return prior, err
+			}
+			return state(setting, prior), nil
+		},
+		update: func(ctx context.Context, client *sendgrid.Client, plan MailSettingFooterResourceModel) (MailSettingFooterResourceModel, error) {
+			setting, err := client.UpdateMailSettingFooter(ctx, sendgrid.MailSettingFooter{
+				Enabled:      plan.Enabled.ValueBool(),
+				HTMLContent:  plan.HTMLContent.ValueString(),
+				PlainContent: plan.PlainContent.ValueString(),
+			})
+			if err != nil {
+				return plan, err
+			}
+			return state(setting, plan), nil
+		},
+		disable: func(ctx context.Context, client *sendgrid.Client) error {
+			_, err := client.UpdateMailSettingFooter(ctx, sendgrid.MailSettingFooter{})
+			return err
+		},
+	}
+}
+
+type MailSettingBouncePurgeResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	SoftBounces types.Int64  `tfsdk:"soft_bounces"`
+	HardBounces types.Int64  `tfsdk:"hard_bounces"`
+	OnBehalfOf  types.String `tfsdk:"on_behalf_of"`
+}
+
+// bouncePurgeDaysAttribute is the schema of the number of days after which
+// bounces are purged. The number is kept by SendGrid when left out.
+func bouncePurgeDaysAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 3650),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func NewMailSettingBouncePurgeResource() resource.Resource {
+	const name = "mail_settings_bounce_purge"
+
+	state := func(setting *sendgrid.MailSettingBouncePurge, prior MailSettingBouncePurgeResourceModel) MailSettingBouncePurgeResourceModel {
+		return MailSettingBouncePurgeResourceModel{
+			ID:          types.StringValue(name),
+			Enabled:     types.BoolValue(setting.Enabled),
+			SoftBounces: types.Int64Value(setting.SoftBounces),
+			HardBounces: types.Int64Value(setting.HardBounces),
+			OnBehalfOf:  prior.OnBehalfOf,
+		}
+	}
+
+	return &settingResource[MailSettingBouncePurgeResourceModel]{
+		name:        name,
+		description: "Resource to purge bounces from the bounce list after a number of days",
+		attributes: map[string]schema.Attribute{
+			"enabled":      settingEnabledAttribute(),
+			"soft_bounces": bouncePurgeDaysAttribute("The number of days after which soft bounces are purged, between 1 and 3650"),
+			"hard_bounces": bouncePurgeDaysAttribute("The number of days after which hard bounces are purged, between 1 and 3650"),
+		},
+		read: func(ctx context.Context, client *sendgrid.Client, prior MailSettingBouncePurgeResourceModel) (MailSettingBouncePurgeResourceModel, error) {
+			setting, err := client.ReadMailSettingBouncePurge(ctx)
+			if err != nil {
+				return prior, err
+			}
+			return state(setting, prior), nil
+		},
+		update: func(ctx context.Context, client *sendgrid.Client, plan MailSettingBouncePurgeResourceModel) (MailSettingBouncePurgeResourceModel, error) {
+			setting, err := client.UpdateMailSettingBouncePurge(ctx, sendgrid.MailSettingBouncePurge{
+				Enabled:     plan.Enabled.ValueBool(),
+				SoftBounces: plan.SoftBounces.ValueInt64(),
+				HardBounces: plan.HardBounces.ValueInt64(),
+			})
+			if err != nil {
+				return plan, err
+			}
+			return state(setting, plan), nil
+		},
+		disable: func(ctx context.Context, client *sendgrid.Client) error {
+			_, err := client.UpdateMailSettingBouncePurge(ctx, sendgrid.MailSettingBouncePurge{})
+			return err
+		},
+	}
+}
+
+type MailSettingForwardResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Email      types.String `tfsdk:"email"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+// newMailSettingForwardResource builds the resources forwarding spam
+// reports and bounces, both settings have the same fields.
+func newMailSettingForwardResource(
+	name, description string,
+	read func(*sendgrid.Client, context.Context) (*sendgrid.MailSettingForward, error),
+	update func(*sendgrid.Client, context.Context, sendgrid.MailSettingForward) (*sendgrid.MailSettingForward, error),
+) resource.Resource {
+	state := func(setting *sendgrid.MailSettingForward, prior MailSettingForwardResourceModel) MailSettingForwardResourceModel {
+		return MailSettingForwardResourceModel{
+			ID:         types.StringValue(name),
+			Enabled:    types.BoolValue(setting.Enabled),
+			Email:      types.StringValue(setting.Email),
+			OnBehalfOf: prior.OnBehalfOf,
+		}
+	}
+
+	return &settingResource[MailSettingForwardResourceModel]{
+		name:        name,
+		description: description,
+		attributes: map[string]schema.Attribute{
+			"enabled": settingEnabledAttribute(),
+			"email": schema.StringAttribute{
+				Description: "The addresses to forward to, separated by commas",
+				Required:    true,
+			},
+		},
+		read: func(ctx context.Context, client *sendgrid.Client, prior MailSettingForwardResourceModel) (MailSettingForwardResourceModel, error) {
+			setting, err := read(client, ctx)
+			if err != nil {
+				return prior, err
+			}
+			return state(setting, prior), nil
+		},
+		update: func(ctx context.Context, client *sendgrid.Client, plan MailSettingForwardResourceModel) (MailSettingForwardResourceModel, error) {
+			setting, err := update(client, ctx, sendgrid.MailSettingForward{
+				Enabled: plan.Enabled.ValueBool(),
+				Email:   plan.Email.ValueString(),
+			})
+			if err != nil {
+				return plan, err
+			}
+			return state(setting, plan), nil
+		},
+		disable: func(ctx context.Context, client *sendgrid.Client) error {
+			_, err := update(client, ctx, sendgrid.MailSettingForward{})
+			return err
+		},
+	}
+}
+
+func NewMailSettingForwardSpamResource() resource.Resource {
+	return newMailSettingForwardResource(
+		"mail_settings_forward_spam",
+		"Resource to forward spam reports to a list of addresses",
+		(*sendgrid.Client).ReadMailSettingForwardSpam,
+		(*sendgrid.Client).UpdateMailSettingForwardSpam,
+	)
+}
+
+func NewMailSettingForwardBounceResource() resource.Resource {
+	return newMailSettingForwardResource(
+		"mail_settings_forward_bounce",
+		"Resource to forward bounces to a list of addresses",
+		(*sendgrid.Client).ReadMailSettingForwardBounce,
+		(*sendgrid.Client).UpdateMailSettingForwardBounce,
+	)
+}
+
+type MailSettingAddressWhitelistResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	List       []string     `tfsdk:"list"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func NewMailSettingAddressWhitelistResource() resource.Resource {
+	const name = "mail_settings_address_whitelist"
+
+	state := func(setting *sendgrid.MailSettingAddressWhitelist, prior MailSettingAddressWhitelistResourceModel) MailSettingAddressWhitelistResourceModel {
+		list := setting.List
+		if list == nil {
+			list = []string{}
+		}
+		return MailSettingAddressWhitelistResourceModel{
+			ID:         types.StringValue(name),
+			Enabled:    types.BoolValue(setting.Enabled),
+			List:       list,
+			OnBehalfOf: prior.OnBehalfOf,
+		}
+	}
+
+	return &settingResource[MailSettingAddressWhitelistResourceModel]{
+		name:        name,
+		description: "Resource to manage the address allow list, emails to the listed addresses and domains are delivered even when they are suppressed",
+		attributes: map[string]schema.Attribute{
+			"enabled": settingEnabledAttribute(),
+			"list": schema.SetAttribute{
+				Description: "The allowed email addresses and domains",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+		read: func(ctx context.Context, client *sendgrid.Client, prior MailSettingAddressWhitelistResourceModel) (MailSettingAddressWhitelistResourceModel, error) {
+			setting, err := client.ReadMailSettingAddressWhitelist(ctx)
+			if err != nil {
+				return prior, err
+			}
+			return state(setting, prior), nil
+		},
+		update: func(ctx context.Context, client *sendgrid.Client, plan MailSettingAddressWhitelistResourceModel) (MailSettingAddressWhitelistResourceModel, error) {
+			setting, err := client.UpdateMailSettingAddressWhitelist(ctx, sendgrid.MailSettingAddressWhitelist{
+				Enabled: plan.Enabled.ValueBool(),
+				List:    plan.List,
+			})
+			if err != nil {
+				return plan, err
+			}
+			return state(setting, plan), nil
+		},
+		disable: func(ctx context.Context, client *sendgrid.Client) error {
+			_, err := client.UpdateMailSettingAddressWhitelist(ctx, sendgrid.MailSettingAddressWhitelist{})
+			return err
+		},
+	}
+}
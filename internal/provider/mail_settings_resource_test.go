@@ -0,0 +1,153 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMailSettingBCCResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "sendgrid_mail_settings_bcc" "test" {
+					email = "archive@example.com"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bcc.test", "id", "mail_settings_bcc"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bcc.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bcc.test", "email", "archive@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailSettingFooterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_mail_settings_footer" "test" {
+					html_content  = "<p>Example Inc.</p>"
+					plain_content = "Example Inc."
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.test", "plain_content", "Example Inc."),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_mail_settings_footer.test",
+				ImportState:       true,
+				ImportStateId:     "mail_settings_footer",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_mail_settings_footer" "test" {
+					enabled       = false
+					plain_content = "Example Inc."
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.test", "html_content", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailSettingBouncePurgeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "sendgrid_mail_settings_bounce_purge" "test" {
+					soft_bounces = 5
+					hard_bounces = 30
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.test", "soft_bounces", "5"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.test", "hard_bounces", "30"),
+				),
+			},
+			{
+				Config: providerConfig + `
+				resource "sendgrid_mail_settings_bounce_purge" "test" {
+					hard_bounces = 60
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.test", "soft_bounces", "5"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.test", "hard_bounces", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailSettingForwardResources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "sendgrid_mail_settings_forward_spam" "test" {
+					email = "abuse@example.com,postmaster@example.com"
+				  }
+
+				resource "sendgrid_mail_settings_forward_bounce" "test" {
+					enabled = false
+					email   = "bounces@example.com"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_forward_spam.test", "email", "abuse@example.com,postmaster@example.com"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_forward_bounce.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_forward_bounce.test", "email", "bounces@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailSettingAddressWhitelistResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "sendgrid_mail_settings_address_whitelist" "test" {
+					list = ["example.com", "ceo@example.org"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_address_whitelist.test", "list.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_mail_settings_address_whitelist.test", "list.*", "example.com"),
+				),
+			},
+			{
+				Config: providerConfig + `
+				resource "sendgrid_mail_settings_address_whitelist" "test" {
+					list = ["example.com"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_address_whitelist.test", "list.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,45 @@
+package sendgrid
+
+import (
+	"strings"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// onBehalfOfAttribute is the schema of the per-resource on_behalf_of
+// override. Objects belong to the account they were created in, so changing
+// it replaces the resource.
+func onBehalfOfAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Username of the subuser to manage this object for. Overrides the provider level subuser.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// clientFor returns client scoped to onBehalfOf when it is set and the
+// provider level client otherwise.
+func clientFor(client *sendgrid.Client, onBehalfOf types.String) *sendgrid.Client {
+	if onBehalfOf.IsNull() || onBehalfOf.IsUnknown() {
+		return client
+	}
+	return client.OnBehalfOf(onBehalfOf.ValueString())
+}
+
+// splitOnBehalfOfImportID splits an import ID of the form <subuser>,<id>.
+// IDs without a subuser prefix are returned unchanged. A comma is used as
+// separator because IDs like whitelisted CIDR ranges may contain slashes.
+func splitOnBehalfOfImportID(importID string) (types.String, string) {
+	subuser, id, found := strings.Cut(importID, ",")
+	if !found || subuser == "" {
+		return types.StringNull(), importID
+	}
+	return types.StringValue(subuser), id
+}
@@ -0,0 +1,63 @@
+package sendgrid
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccOnBehalfOf checks that a provider level subuser is impersonated for
+// subuser resources while the subuser itself is managed as the parent
+// account. It needs the fake to record the on-behalf-of header.
+func TestAccOnBehalfOf(t *testing.T) {
+	if testAccServer == nil {
+		t.Skip("requires the fake SendGrid API")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "sendgrid" {
+					subuser = "obo.test"
+				}
+
+				resource "sendgrid_subuser" "test" {
+					email    = "obo@example.com"
+					username = "obo.test"
+					ips      = ["192.0.2.1"]
+					password = "C3|zh!%SR],jgD5d"
+				  }
+
+				resource "sendgrid_unsubscribe_group" "test" {
+					name        = "obo"
+					description = "Created on behalf of the subuser"
+
+					depends_on = [sendgrid_subuser.test]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.test", "username", "obo.test"),
+					resource.TestCheckResourceAttrSet("sendgrid_unsubscribe_group.test", "id"),
+					testAccCheckOnBehalfOf("obo.test POST /v3/asm/groups"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckOnBehalfOf checks that the fake served the request on behalf of
+// a subuser.
+func testAccCheckOnBehalfOf(want string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		for _, got := range testAccServer.OnBehalfOf() {
+			if got == want {
+				return nil
+			}
+		}
+		return fmt.Errorf("no request %q sent on behalf of a subuser, got %q", want, testAccServer.OnBehalfOf())
+	}
+}
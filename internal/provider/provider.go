@@ -4,14 +4,18 @@ import (
 	"context"
 	"log"
 	"os"
+	"time"
 
 	sendgrid "terraform-provider-sendgrid/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -48,12 +52,48 @@ func (p *sendgridProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"base_url": schema.StringAttribute{
+				Description: "Base URL of the SendGrid API including the version path, e.g. https://api.sendgrid.com/v3. " +
+					"Takes precedence over region. Can also be set with the SENDGRID_BASE_URL environment variable.",
+				Optional: true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Data residency region of the SendGrid account, either global or eu. Defaults to global.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{sendgrid.RegionGlobal, sendgrid.RegionEU}...),
+				},
+			},
+			"subuser": schema.StringAttribute{
+				Description: "Username of a subuser to impersonate through the on-behalf-of header. " +
+					"Resources supporting on_behalf_of can override it. Subusers, teammates and IPs are always managed as the parent account.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries for requests failing with a rate limit (429), server error (5xx) or network error. Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: "Maximum number of seconds to wait between two retries. A rate limited request is not retried if SendGrid asks to wait longer than this. Defaults to 30.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
 
 type sendgridProviderModel struct {
-	ApiKey types.String `tfsdk:"apikey"`
+	ApiKey       types.String `tfsdk:"apikey"`
+	BaseURL      types.String `tfsdk:"base_url"`
+	Region       types.String `tfsdk:"region"`
+	Subuser      types.String `tfsdk:"subuser"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
 }
 
 func (p *sendgridProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -76,6 +116,51 @@ func (p *sendgridProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.BaseURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Unknown SendGrid API base URL",
+			"The provider cannot create the SendGrid API client as there is an unknown configuration value for the SendGrid base_url. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SENDGRID_BASE_URL environment variable.",
+		)
+	}
+
+	if config.Subuser.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subuser"),
+			"Unknown SendGrid subuser",
+			"The provider cannot create the SendGrid API client as there is an unknown configuration value for the SendGrid subuser. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.Region.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown SendGrid region",
+			"The provider cannot create the SendGrid API client as there is an unknown configuration value for the SendGrid region. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown SendGrid max retries",
+			"The provider cannot create the SendGrid API client as there is an unknown configuration value for the SendGrid max_retries. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RetryMaxWait.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Unknown SendGrid retry max wait",
+			"The provider cannot create the SendGrid API client as there is an unknown configuration value for the SendGrid retry_max_wait. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -100,11 +185,34 @@ func (p *sendgridProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	max_retries := sendgrid.DefaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		max_retries = int(config.MaxRetries.ValueInt64())
+	}
+
+	retry_max_wait := sendgrid.DefaultRetryMaxWait
+	if !config.RetryMaxWait.IsNull() {
+		retry_max_wait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	base_url := os.Getenv("SENDGRID_BASE_URL")
+
+	if !config.BaseURL.IsNull() {
+		base_url = config.BaseURL.ValueString()
+	}
+
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "apikey")
+	ctx = tflog.SetField(ctx, "sendgrid_region", config.Region.ValueString())
+	ctx = tflog.SetField(ctx, "sendgrid_base_url", base_url)
 
 	tflog.Debug(ctx, "Creating SendGrid API Client")
 
-	client, err := sendgrid.NewClient(api_key)
+	client, err := sendgrid.NewClient(api_key,
+		sendgrid.WithRegion(config.Region.ValueString()),
+		sendgrid.WithBaseURL(base_url),
+		sendgrid.WithRetry(max_retries, retry_max_wait),
+		sendgrid.WithSubuser(config.Subuser.ValueString()),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Create SendGrid API Client",
 			"An unexpected error occurred when creating the SendGrid API client. "+
@@ -131,6 +239,31 @@ func (p *sendgridProvider) Resources(_ context.Context) []func() resource.Resour
 		NewLinkbrandValidateResource,
 		NewDomainValidateResource,
 		NewDomainSubuserResource,
+		NewTemplateResource,
+		NewTemplateVersionResource,
+		NewUnsubscribeGroupResource,
+		NewGlobalSuppressionResource,
+		NewGroupSuppressionResource,
+		NewEventWebhookResource,
+		NewInboundParseResource,
+		NewMailSettingBCCResource,
+		NewMailSettingFooterResource,
+		NewMailSettingBouncePurgeResource,
+		NewMailSettingForwardSpamResource,
+		NewMailSettingForwardBounceResource,
+		NewMailSettingAddressWhitelistResource,
+		NewTrackingSettingClickResource,
+		NewTrackingSettingOpenResource,
+		NewTrackingSettingSubscriptionResource,
+		NewTrackingSettingGoogleAnalyticsResource,
+		NewIPPoolResource,
+		NewIPPoolIPResource,
+		NewIPWarmupResource,
+		NewSubuserIPsResource,
+		NewReverseDNSResource,
+		NewReverseDNSValidateResource,
+		NewAlertResource,
+		NewSubuserCreditsResource,
 		//NewValidateDomainResource,
 		//	NewResendTmateResource,
 	}
@@ -142,5 +275,9 @@ func (p *sendgridProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewipwhitelistDataSource,
 		NewSubuserDataSource,
 		NewdomainauthDataSource,
+		NewlinkbrandDataSource,
+		NewUnsubscribeGroupDataSource,
+		NewIPsDataSource,
+		NewVerifiedSendersDataSource,
 	}
 }
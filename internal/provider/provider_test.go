@@ -1,15 +1,21 @@
 package sendgrid
 
 import (
+	"os"
+	"testing"
+
+	"terraform-provider-sendgrid/internal/sendgridtest"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 const (
+	// providerConfig leaves the credentials and the endpoint to the
+	// SENDGRID_API_KEY and SENDGRID_BASE_URL environment variables, see
+	// TestMain.
 	providerConfig = `
-	provider "sendgrid" {
-		apikey = "SG.*****************************************************"
-	  }
+	provider "sendgrid" {}
 	`
 )
 
@@ -21,4 +27,27 @@ var (
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"sendgrid": providerserver.NewProtocol6WithError(New("test")()),
 	}
+
+	// testAccServer is the in-memory SendGrid API the acceptance tests run
+	// against when no real API key is given. It is nil for live runs.
+	testAccServer *sendgridtest.Server
 )
+
+// TestMain points acceptance tests at a local fake of the SendGrid API unless
+// SENDGRID_API_KEY is set, so that the suite runs offline in CI:
+//
+//	TF_ACC=1 go test ./internal/provider/...                           # fake
+//	TF_ACC=1 SENDGRID_API_KEY=SG.xxx go test ./internal/provider/...   # live
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") == "" || os.Getenv("SENDGRID_API_KEY") != "" {
+		os.Exit(m.Run())
+	}
+
+	testAccServer = sendgridtest.NewServer()
+	os.Setenv("SENDGRID_API_KEY", sendgridtest.APIKey)
+	os.Setenv("SENDGRID_BASE_URL", testAccServer.BaseURL())
+
+	code := m.Run()
+	testAccServer.Close()
+	os.Exit(code)
+}
@@ -0,0 +1,270 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &reverseDNSResource{}
+	_ resource.ResourceWithConfigure   = &reverseDNSResource{}
+	_ resource.ResourceWithImportState = &reverseDNSResource{}
+)
+
+func NewReverseDNSResource() resource.Resource {
+	return &reverseDNSResource{}
+}
+
+type reverseDNSResource struct {
+	client *sendgrid.Client
+}
+
+type ReverseDNSResourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	IP        types.String `tfsdk:"ip"`
+	Domain    types.String `tfsdk:"domain"`
+	Subdomain types.String `tfsdk:"subdomain"`
+	Rdns      types.String `tfsdk:"rdns"`
+	Valid     types.Bool   `tfsdk:"valid"`
+	Legacy    types.Bool   `tfsdk:"legacy"`
+	ARecord   types.Object `tfsdk:"a_record"`
+}
+
+// dnsRecordAttributeTypes are the attributes of a DNS record object, named
+// like the records of sendgrid_linkbrand.
+var dnsRecordAttributeTypes = map[string]attr.Type{
+	"valid": types.BoolType,
+	"types": types.StringType,
+	"host":  types.StringType,
+	"data":  types.StringType,
+}
+
+func dnsRecordObject(record sendgrid.Domainauthdnsrecord) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(dnsRecordAttributeTypes, map[string]attr.Value{
+		"valid": types.BoolValue(record.Valid),
+		"types": types.StringValue(record.Type),
+		"host":  types.StringValue(record.Host),
+		"data":  types.StringValue(record.Data),
+	})
+}
+
+func (r *reverseDNSResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reverse_dns"
+}
+
+func (r *reverseDNSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to set up reverse DNS for a dedicated IP. Add the A record to the DNS of the domain, then validate it with sendgrid_reverse_dns_validate",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the reverse DNS record",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Description: "The dedicated IP to set up reverse DNS for",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "The root domain the reverse DNS hostname is under",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subdomain": schema.StringAttribute{
+				Description: "The subdomain of the reverse DNS hostname. Generated by SendGrid when not set",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rdns": schema.StringAttribute{
+				Description: "The reverse DNS hostname of the IP",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"valid": schema.BoolAttribute{
+				Description: "Whether the A record has been validated",
+				Computed:    true,
+			},
+			"legacy": schema.BoolAttribute{
+				Description: "Whether the record was created with the legacy reverse DNS process",
+				Computed:    true,
+			},
+			"a_record": schema.SingleNestedAttribute{
+				Description: "The A record to add to the DNS of the domain",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"valid": schema.BoolAttribute{
+						Description: "Whether the record has been validated",
+						Computed:    true,
+					},
+					"types": schema.StringAttribute{
+						Description: "The type of the record",
+						Computed:    true,
+					},
+					"host": schema.StringAttribute{
+						Description: "The host of the record",
+						Computed:    true,
+					},
+					"data": schema.StringAttribute{
+						Description: "The value of the record, the IP",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func reverseDNSState(rdns *sendgrid.ReverseDNS) (ReverseDNSResourceModel, diag.Diagnostics) {
+	aRecord, diags := dnsRecordObject(rdns.ARecord)
+
+	return ReverseDNSResourceModel{
+		ID:        types.Int64Value(rdns.ID),
+		IP:        types.StringValue(rdns.IP),
+		Domain:    types.StringValue(rdns.Domain),
+		Subdomain: types.StringValue(rdns.Subdomain),
+		Rdns:      types.StringValue(rdns.Rdns),
+		Valid:     types.BoolValue(rdns.Valid),
+		Legacy:    types.BoolValue(rdns.Legacy),
+		ARecord:   aRecord,
+	}, diags
+}
+
+func (r *reverseDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ReverseDNSResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rdns, err := r.client.CreateReverseDNS(ctx, sendgrid.ReverseDNS{
+		IP:        plan.IP.ValueString(),
+		Domain:    plan.Domain.ValueString(),
+		Subdomain: plan.Subdomain.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating reverse DNS", "Could not create reverse DNS: ", err, "ip", "domain", "subdomain")
+		return
+	}
+
+	tflog.Debug(ctx, "Created reverse DNS", map[string]any{"id": rdns.ID, "rdns": rdns.Rdns})
+
+	state, diags := reverseDNSState(rdns)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *reverseDNSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ReverseDNSResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rdns, err := r.client.ReadReverseDNS(ctx, state.ID.ValueInt64())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Reverse DNS not found, removing from state", map[string]any{"id": state.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading reverse DNS",
+			fmt.Sprintf("Could not read reverse DNS %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	state, diags = reverseDNSState(rdns)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update is never called, changing any argument replaces the resource.
+func (r *reverseDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *reverseDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ReverseDNSResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteReverseDNS(ctx, state.ID.ValueInt64())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting reverse DNS",
+			fmt.Sprintf("Could not delete reverse DNS %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted reverse DNS", map[string]any{"id": state.ID.ValueInt64()})
+}
+
+func (r *reverseDNSResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *reverseDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing reverse DNS",
+			fmt.Sprintf("Reverse DNS ID must be a number, got %q: %s", req.ID, err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,53 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The IP is a dedicated IP of the account of the fake, see
+// sendgridtest.DedicatedIPs.
+func TestAccReverseDNSResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_reverse_dns" "test" {
+					ip        = "192.0.2.4"
+					domain    = "example.com"
+					subdomain = "mail"
+				  }
+
+				resource "sendgrid_reverse_dns_validate" "test" {
+					id = sendgrid_reverse_dns.test.id
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_reverse_dns.test", "id"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "rdns", "mail.example.com"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.types", "a"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.host", "mail.example.com"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.data", "192.0.2.4"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns_validate.test", "valid", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_reverse_dns.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The validation ran after the reverse DNS was created.
+				ImportStateVerifyIgnore: []string{"valid", "a_record.valid"},
+			},
+			{
+				ResourceName:      "sendgrid_reverse_dns_validate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
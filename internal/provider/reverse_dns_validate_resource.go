@@ -0,0 +1,164 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &reverseDNSValidateResource{}
+	_ resource.ResourceWithConfigure   = &reverseDNSValidateResource{}
+	_ resource.ResourceWithImportState = &reverseDNSValidateResource{}
+)
+
+func NewReverseDNSValidateResource() resource.Resource {
+	return &reverseDNSValidateResource{}
+}
+
+type reverseDNSValidateResource struct {
+	client *sendgrid.Client
+}
+
+type ReverseDNSValidateResourceModel struct {
+	ID    types.Int64 `tfsdk:"id"`
+	Valid types.Bool  `tfsdk:"valid"`
+}
+
+func (r *reverseDNSValidateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reverse_dns_validate"
+}
+
+func (r *reverseDNSValidateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to validate the A record of a reverse DNS record. Creating it fails until the record is valid",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the reverse DNS record",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"valid": schema.BoolAttribute{
+				Description: "Whether the A record is valid",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *reverseDNSValidateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ReverseDNSValidateResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rdns, err := r.client.ValidateReverseDNS(ctx, plan.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error validating reverse DNS",
+			fmt.Sprintf("Could not validate reverse DNS %d: %s", plan.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	if !rdns.Valid {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Reverse DNS is not valid",
+			fmt.Sprintf("The A record of %s is not valid yet. Add the A record %s pointing to %s and retry.", rdns.IP, rdns.ARecord.Host, rdns.ARecord.Data),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Validated reverse DNS", map[string]any{"id": rdns.ID, "rdns": rdns.Rdns})
+
+	diags = resp.State.Set(ctx, ReverseDNSValidateResourceModel{
+		ID:    types.Int64Value(rdns.ID),
+		Valid: types.BoolValue(rdns.Valid),
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *reverseDNSValidateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ReverseDNSValidateResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rdns, err := r.client.ReadReverseDNS(ctx, state.ID.ValueInt64())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Reverse DNS not found, removing from state", map[string]any{"id": state.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading reverse DNS",
+			fmt.Sprintf("Could not read reverse DNS %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, ReverseDNSValidateResourceModel{
+		ID:    types.Int64Value(rdns.ID),
+		Valid: types.BoolValue(rdns.Valid),
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called, changing the id replaces the resource.
+func (r *reverseDNSValidateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete only removes the resource from the state, a validation cannot be
+// undone.
+func (r *reverseDNSValidateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *reverseDNSValidateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *reverseDNSValidateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing reverse DNS validation",
+			fmt.Sprintf("Reverse DNS ID must be a number, got %q: %s", req.ID, err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,56 @@
+package sendgrid
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The fake never validates domains under .invalid, the IP is a dedicated IP
+// of its account, see sendgridtest.DedicatedIPs.
+func TestAccReverseDNSValidateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Not valid testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_reverse_dns" "test" {
+					ip     = "192.0.2.10"
+					domain = "example.invalid"
+				  }
+
+				resource "sendgrid_reverse_dns_validate" "test" {
+					id = sendgrid_reverse_dns.test.id
+				  }
+`,
+				ExpectError: regexp.MustCompile("Reverse DNS is not valid"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_reverse_dns" "test" {
+					ip     = "192.0.2.10"
+					domain = "example.com"
+				  }
+
+				resource "sendgrid_reverse_dns_validate" "test" {
+					id = sendgrid_reverse_dns.test.id
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sendgrid_reverse_dns_validate.test", "id", "sendgrid_reverse_dns.test", "id"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns_validate.test", "valid", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_reverse_dns_validate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
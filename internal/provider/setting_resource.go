@@ -0,0 +1,211 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// settingResource implements the resources of account level settings, like
+// mail and tracking settings. A setting always exists: creating the resource
+// updates it and destroying the resource disables it again. The ID of the
+// resource is its type name without the provider prefix.
+//
+// M is the model of the resource, it must have the id and on_behalf_of
+// attributes of settingIDAttribute and onBehalfOfAttribute.
+type settingResource[M any] struct {
+	client *sendgrid.Client
+
+	// name is the type name without the provider prefix, like
+	// mail_settings_footer.
+	name        string
+	description string
+	attributes  map[string]schema.Attribute
+
+	// read returns the current setting. prior is the state, values SendGrid
+	// never returns are taken from it.
+	read func(ctx context.Context, client *sendgrid.Client, prior M) (M, error)
+	// update applies the plan and returns the new setting.
+	update func(ctx context.Context, client *sendgrid.Client, plan M) (M, error)
+	// disable reverts the setting to disabled.
+	disable func(ctx context.Context, client *sendgrid.Client) error
+}
+
+var _ resource.ResourceWithImportState = &settingResource[struct{}]{}
+
+func (r *settingResource[M]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.name
+}
+
+func (r *settingResource[M]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id":           settingIDAttribute(r.name),
+		"on_behalf_of": onBehalfOfAttribute(),
+	}
+	for name, attribute := range r.attributes {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description: r.description + ". Destroying the resource disables the setting",
+		Attributes:  attributes,
+	}
+}
+
+// settingIDAttribute is the schema of the ID of a setting, always name.
+func settingIDAttribute(name string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Always " + name,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// settingEnabledAttribute is the schema of the enabled flag of a setting,
+// enabled unless configured otherwise.
+func settingEnabledAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Whether the setting is enabled. Defaults to true",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	}
+}
+
+// settingStringAttribute is the schema of an optional text field of a
+// setting, empty unless configured.
+func settingStringAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(""),
+	}
+}
+
+func (r *settingResource[M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan M
+	var onBehalfOf types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("on_behalf_of"), &onBehalfOf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := r.update(ctx, clientFor(r.client, onBehalfOf), plan)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating "+r.name, "Could not update "+r.name+": ", err, r.attributeNames()...)
+		return
+	}
+
+	tflog.Debug(ctx, "Updated setting", map[string]any{"setting": r.name})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, setting)...)
+}
+
+func (r *settingResource[M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state M
+	var onBehalfOf types.String
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_behalf_of"), &onBehalfOf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := r.read(ctx, clientFor(r.client, onBehalfOf), state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading "+r.name,
+			fmt.Sprintf("Could not read %s: %s", r.name, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, setting)...)
+}
+
+func (r *settingResource[M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan M
+	var onBehalfOf types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_behalf_of"), &onBehalfOf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := r.update(ctx, clientFor(r.client, onBehalfOf), plan)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating "+r.name, "Could not update "+r.name+": ", err, r.attributeNames()...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, setting)...)
+}
+
+func (r *settingResource[M]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var onBehalfOf types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_behalf_of"), &onBehalfOf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.disable(ctx, clientFor(r.client, onBehalfOf)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error disabling "+r.name,
+			fmt.Sprintf("Could not disable %s: %s", r.name, err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Disabled setting", map[string]any{"setting": r.name})
+}
+
+func (r *settingResource[M]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState accepts any ID, optionally prefixed with the subuser to
+// import the setting of.
+func (r *settingResource[M]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	onBehalfOf, _ := splitOnBehalfOfImportID(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
+}
+
+func (r *settingResource[M]) attributeNames() []string {
+	names := make([]string, 0, len(r.attributes))
+	for name := range r.attributes {
+		names = append(names, name)
+	}
+	return names
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	sendgrid "terraform-provider-sendgrid/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -21,6 +25,7 @@ var (
 	_ resource.Resource                = &singlesenderResource{}
 	_ resource.ResourceWithConfigure   = &singlesenderResource{}
 	_ resource.ResourceWithImportState = &singlesenderResource{}
+	_ resource.ResourceWithModifyPlan  = &singlesenderResource{}
 )
 
 func NewSingleSenderResource() resource.Resource {
@@ -46,6 +51,25 @@ type SingleSenderModel struct {
 	ID          types.Int64  `tfsdk:"id"`
 	Verified    types.Bool   `tfsdk:"verified"`
 	Locked      types.Bool   `tfsdk:"locked"`
+	OnBehalfOf  types.String `tfsdk:"on_behalf_of"`
+
+	WaitForVerification types.Bool   `tfsdk:"wait_for_verification"`
+	VerificationTimeout types.Int64  `tfsdk:"verification_timeout"`
+	ResendVerification  types.String `tfsdk:"resend_verification"`
+}
+
+// defaultSingleSenderVerificationTimeout is used when verification_timeout
+// is not set.
+const defaultSingleSenderVerificationTimeout = 10 * time.Minute
+
+// singleSenderVerificationPollInterval is the time between two checks of
+// the verified flag while waiting for the verification.
+var singleSenderVerificationPollInterval = 10 * time.Second
+
+// singleSenderAttributes are the configurable attributes SendGrid may report
+// field level validation errors for.
+var singleSenderAttributes = []string{
+	"nickname", "from_email", "from_name", "reply_to", "reply_to_name", "address", "address2", "state", "city", "country", "zip",
 }
 
 func (r *singlesenderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -116,10 +140,139 @@ func (r *singlesenderResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "Locked of the sender",
 				Computed:    true,
 			},
+			"on_behalf_of": onBehalfOfAttribute(),
+			"wait_for_verification": schema.BoolAttribute{
+				Description: "Whether to wait until the sender is verified, the link in the verification email clicked, when creating or updating it. " +
+					"When the sender is not verified within verification_timeout, creating it only warns and updating it fails. " +
+					"Every following apply waits again until it is verified, without recreating the sender. Defaults to false",
+				Optional: true,
+			},
+			"verification_timeout": schema.Int64Attribute{
+				Description: "Maximum number of seconds to wait for the verification when wait_for_verification is true. Defaults to 600",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"resend_verification": schema.StringAttribute{
+				Description: "Any value, changing it sends the verification email again when the sender is not verified yet",
+				Optional:    true,
+			},
 		},
 	}
 }
 
+// ModifyPlan plans an update while wait_for_verification is set and the
+// sender is not verified, so the wait is retried by every apply until it is.
+func (r *singlesenderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SingleSenderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.WaitForVerification.ValueBool() && !state.Verified.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("verified"), types.BoolUnknown())...)
+	}
+}
+
+// singleSenderState builds the state of sender. The attributes controlling
+// the verification are not part of the API and are taken from model.
+func singleSenderState(sender *sendgrid.ReturnSinglesender, model SingleSenderModel) SingleSenderModel {
+	return SingleSenderModel{
+		Nickname:            types.StringValue(sender.Nickname),
+		FromEmail:           types.StringValue(sender.FromEmail),
+		FromName:            types.StringValue(sender.FromName),
+		ReplyTo:             types.StringValue(sender.ReplyTo),
+		ReplyToName:         types.StringValue(sender.ReplyToName),
+		Address:             types.StringValue(sender.Address),
+		Address2:            types.StringValue(sender.Address2),
+		State:               types.StringValue(sender.State),
+		City:                types.StringValue(sender.City),
+		Country:             types.StringValue(sender.Country),
+		Zip:                 types.StringValue(sender.Zip),
+		ID:                  types.Int64Value(sender.ID),
+		Verified:            types.BoolValue(sender.Verified),
+		Locked:              types.BoolValue(sender.Locked),
+		OnBehalfOf:          model.OnBehalfOf,
+		WaitForVerification: model.WaitForVerification,
+		VerificationTimeout: model.VerificationTimeout,
+		ResendVerification:  model.ResendVerification,
+	}
+}
+
+// waitForSingleSenderVerification polls the sender until it is verified or
+// the timeout is reached. It returns the last sender read.
+func waitForSingleSenderVerification(ctx context.Context, client *sendgrid.Client, sender *sendgrid.ReturnSinglesender, timeout time.Duration) (*sendgrid.ReturnSinglesender, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for !sender.Verified {
+		tflog.Debug(ctx, "Waiting for single sender verification", map[string]any{"id": sender.ID, "from_email": sender.FromEmail})
+
+		timer := time.NewTimer(singleSenderVerificationPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return sender, fmt.Errorf("%s is not verified after %s", sender.FromEmail, timeout)
+		case <-timer.C:
+		}
+
+		read, err := client.ReadSingleSender(ctx, fmt.Sprintf("%d", sender.ID))
+		if err != nil {
+			if ctx.Err() != nil {
+				return sender, fmt.Errorf("%s is not verified after %s", sender.FromEmail, timeout)
+			}
+			return sender, err
+		}
+		sender = read
+	}
+
+	return sender, nil
+}
+
+// verify resends the verification email when the resend_verification
+// trigger changed and waits for the verification when requested. The
+// returned sender is the latest one read, also on error. A failed wait is
+// returned rather than added to diags, the caller decides how severe it is.
+func (r *singlesenderResource) verify(ctx context.Context, sender *sendgrid.ReturnSinglesender, plan SingleSenderModel, resend bool, diags *diag.Diagnostics) (*sendgrid.ReturnSinglesender, error) {
+	client := clientFor(r.client, plan.OnBehalfOf)
+
+	if resend && !sender.Verified {
+		_, err := client.ResendSingleSenderVerification(ctx, fmt.Sprintf("%d", sender.ID))
+		if err != nil {
+			diags.AddError(
+				"Error resending single sender verification",
+				fmt.Sprintf("Could not resend the verification email of %s: %s", sender.FromEmail, err),
+			)
+			return sender, nil
+		}
+		tflog.Debug(ctx, "Resent single sender verification", map[string]any{"id": sender.ID, "from_email": sender.FromEmail})
+	}
+
+	if !plan.WaitForVerification.ValueBool() {
+		return sender, nil
+	}
+
+	timeout := defaultSingleSenderVerificationTimeout
+	if !plan.VerificationTimeout.IsNull() {
+		timeout = time.Duration(plan.VerificationTimeout.ValueInt64()) * time.Second
+	}
+
+	return waitForSingleSenderVerification(ctx, client, sender, timeout)
+}
+
+// notVerifiedDetail explains a failed wait for the verification of sender.
+func notVerifiedDetail(sender *sendgrid.ReturnSinglesender, err error) string {
+	return fmt.Sprintf("Could not wait for the verification of single sender %d: %s. "+
+		"Click the link in the verification email and apply again, or change resend_verification to send it again.", sender.ID, err)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *singlesenderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 
@@ -144,33 +297,24 @@ func (r *singlesenderResource) Create(ctx context.Context, req resource.CreateRe
 		Zip:         newstate.Zip.ValueString(),
 	}
 
-	singlesenderresponse, err := r.client.CreateSingleSender(ctx, item)
+	singlesenderresponse, err := clientFor(r.client, newstate.OnBehalfOf).CreateSingleSender(ctx, item)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating single sender",
-			fmt.Sprintf("Error creating single sender: %s", err.Error()),
-		)
+		addClientError(&resp.Diagnostics, "Error creating single sender", "Error creating single sender: ", err, singleSenderAttributes...)
 
 		return
 	}
 
-	newstate = SingleSenderModel{
-		Nickname:    types.StringValue(singlesenderresponse.Nickname),
-		FromEmail:   types.StringValue(singlesenderresponse.FromEmail),
-		FromName:    types.StringValue(singlesenderresponse.FromName),
-		ReplyTo:     types.StringValue(singlesenderresponse.ReplyTo),
-		ReplyToName: types.StringValue(singlesenderresponse.ReplyToName),
-		Address:     types.StringValue(singlesenderresponse.Address),
-		Address2:    types.StringValue(singlesenderresponse.Address2),
-		State:       types.StringValue(singlesenderresponse.State),
-		City:        types.StringValue(singlesenderresponse.City),
-		Country:     types.StringValue(singlesenderresponse.Country),
-		Zip:         types.StringValue(singlesenderresponse.Zip),
-		ID:          types.Int64Value(singlesenderresponse.ID),
-		Verified:    types.BoolValue(singlesenderresponse.Verified),
-		Locked:      types.BoolValue(singlesenderresponse.Locked),
+	// creating the sender sends the verification email, there is nothing
+	// to resend yet. A failed wait only warns: an error would taint the
+	// sender and the next apply would recreate it, sending a new email,
+	// while ModifyPlan already makes it wait again.
+	singlesenderresponse, err = r.verify(ctx, singlesenderresponse, newstate, false, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("wait_for_verification"), "Single sender not verified", notVerifiedDetail(singlesenderresponse, err))
 	}
 
+	newstate = singleSenderState(singlesenderresponse, newstate)
+
 	diags = resp.State.Set(ctx, newstate)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -189,8 +333,14 @@ func (r *singlesenderResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 	//tflog.Debug(ctx, "ReadingResource:", map[string]any{"id": readstate.ID.ValueInt64()})
-	readsinglesenderresponse, err := r.client.ReadSingleSender(ctx, fmt.Sprintf("%d", readstate.ID.ValueInt64()))
+	readsinglesenderresponse, err := clientFor(r.client, readstate.OnBehalfOf).ReadSingleSender(ctx, fmt.Sprintf("%d", readstate.ID.ValueInt64()))
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Single sender not found, removing from state", map[string]any{"id": readstate.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error creating single sender",
 			fmt.Sprintf("Error creating single sender: %s", err.Error()),
@@ -199,22 +349,7 @@ func (r *singlesenderResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	readstate = SingleSenderModel{
-		Nickname:    types.StringValue(readsinglesenderresponse.Nickname),
-		FromEmail:   types.StringValue(readsinglesenderresponse.FromEmail),
-		FromName:    types.StringValue(readsinglesenderresponse.FromName),
-		ReplyTo:     types.StringValue(readsinglesenderresponse.ReplyTo),
-		ReplyToName: types.StringValue(readsinglesenderresponse.ReplyToName),
-		Address:     types.StringValue(readsinglesenderresponse.Address),
-		Address2:    types.StringValue(readsinglesenderresponse.Address2),
-		State:       types.StringValue(readsinglesenderresponse.State),
-		City:        types.StringValue(readsinglesenderresponse.City),
-		Country:     types.StringValue(readsinglesenderresponse.Country),
-		Zip:         types.StringValue(readsinglesenderresponse.Zip),
-		ID:          types.Int64Value(readsinglesenderresponse.ID),
-		Verified:    types.BoolValue(readsinglesenderresponse.Verified),
-		Locked:      types.BoolValue(readsinglesenderresponse.Locked),
-	}
+	readstate = singleSenderState(readsinglesenderresponse, readstate)
 
 	diags = resp.State.Set(ctx, readstate)
 	resp.Diagnostics.Append(diags...)
@@ -226,9 +361,10 @@ func (r *singlesenderResource) Read(ctx context.Context, req resource.ReadReques
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *singlesenderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 
-	var updatestate SingleSenderModel
+	var updatestate, priorstate SingleSenderModel
 	diags := req.Plan.Get(ctx, &updatestate)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorstate)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -250,33 +386,21 @@ func (r *singlesenderResource) Update(ctx context.Context, req resource.UpdateRe
 		ID:          updatestate.ID.ValueInt64(),
 	}
 
-	updatesinglesenderresponse, err := r.client.UpdateSingleSender(ctx, updateitem)
+	updatesinglesenderresponse, err := clientFor(r.client, updatestate.OnBehalfOf).UpdateSingleSender(ctx, updateitem)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating single sender",
-			fmt.Sprintf("Error updating single sender: %s", err.Error()),
-		)
+		addClientError(&resp.Diagnostics, "Error updating single sender", "Error updating single sender: ", err, singleSenderAttributes...)
 
 		return
 	}
 
-	updatestate = SingleSenderModel{
-		Nickname:    types.StringValue(updatesinglesenderresponse.Nickname),
-		FromEmail:   types.StringValue(updatesinglesenderresponse.FromEmail),
-		FromName:    types.StringValue(updatesinglesenderresponse.FromName),
-		ReplyTo:     types.StringValue(updatesinglesenderresponse.ReplyTo),
-		ReplyToName: types.StringValue(updatesinglesenderresponse.ReplyToName),
-		Address:     types.StringValue(updatesinglesenderresponse.Address),
-		Address2:    types.StringValue(updatesinglesenderresponse.Address2),
-		State:       types.StringValue(updatesinglesenderresponse.State),
-		City:        types.StringValue(updatesinglesenderresponse.City),
-		Country:     types.StringValue(updatesinglesenderresponse.Country),
-		Zip:         types.StringValue(updatesinglesenderresponse.Zip),
-		ID:          types.Int64Value(updatesinglesenderresponse.ID),
-		Verified:    types.BoolValue(updatesinglesenderresponse.Verified),
-		Locked:      types.BoolValue(updatesinglesenderresponse.Locked),
+	resend := !updatestate.ResendVerification.IsNull() && !updatestate.ResendVerification.Equal(priorstate.ResendVerification)
+	updatesinglesenderresponse, err = r.verify(ctx, updatesinglesenderresponse, updatestate, resend, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_for_verification"), "Single sender not verified", notVerifiedDetail(updatesinglesenderresponse, err))
 	}
 
+	updatestate = singleSenderState(updatesinglesenderresponse, updatestate)
+
 	diags = resp.State.Set(ctx, updatestate)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -295,7 +419,7 @@ func (r *singlesenderResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	_, err := r.client.DeleteSingleSender(ctx, fmt.Sprintf("%d", delsender.ID.ValueInt64()))
+	_, err := clientFor(r.client, delsender.OnBehalfOf).DeleteSingleSender(ctx, fmt.Sprintf("%d", delsender.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Single Sender",
@@ -331,7 +455,8 @@ func (r *singlesenderResource) Configure(_ context.Context, req resource.Configu
 // ImportState imports the resource state from the Terraform state.
 func (r *singlesenderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
-	id, err := strconv.ParseInt(req.ID, 10, 64)
+	onBehalfOf, importID := splitOnBehalfOfImportID(req.ID)
+	id, err := strconv.ParseInt(importID, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing Single Sender Authentication",
@@ -341,4 +466,5 @@ func (r *singlesenderResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
 }
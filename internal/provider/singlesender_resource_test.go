@@ -1,9 +1,12 @@
 package sendgrid
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccsinglesenderResource(t *testing.T) {
@@ -40,7 +43,7 @@ resource "sendgrid_single_sender" "test" {
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "address2", "Apt 123"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "city", "San Francisco"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "state", "CA"),
-					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "zip", "95639"),
+					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "zip", "95369"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "country", "US"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "verified", "false"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "locked", "false"),
@@ -86,7 +89,7 @@ resource "sendgrid_single_sender" "test" {
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "address2", "Apt 123"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "city", "San Francisco"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "state", "CA"),
-					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "zip", "95639"),
+					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "zip", "95369"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "country", "US"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "verified", "false"),
 					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "locked", "false"),
@@ -96,3 +99,73 @@ resource "sendgrid_single_sender" "test" {
 		},
 	})
 }
+
+// TestAccSingleSenderResourceVerification needs the fake to verify the
+// sender, nobody clicks the verification email during live runs.
+func TestAccSingleSenderResourceVerification(t *testing.T) {
+	if testAccServer == nil {
+		t.Skip("requires the fake SendGrid API")
+	}
+
+	config := func(resend string, wait bool) string {
+		return providerConfig + fmt.Sprintf(`
+resource "sendgrid_single_sender" "test" {
+	nickname = "verification"
+	from_email = "verification@example.com"
+	from_name = "Verification"
+	reply_to = "verification@example.com"
+	reply_to_name = "Verification"
+	address = "1234 Fake St"
+	address2 = ""
+	city = "San Francisco"
+	state = "CA"
+	zip = "95369"
+	country = "US"
+	resend_verification = %q
+	wait_for_verification = %t
+	verification_timeout = 1
+}
+`, resend, wait)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create timeout testing, the sender is kept and the next plan
+			// waits again
+			{
+				Config: config("1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "verified", "false"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// The failed wait is retried by updating, not recreating, the sender
+			{
+				Config: config("1", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sendgrid_single_sender.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectError: regexp.MustCompile("is not verified after 1s"),
+			},
+			// Timeout testing, the verification email is sent again
+			{
+				Config:      config("2", true),
+				ExpectError: regexp.MustCompile("is not verified after 1s"),
+			},
+			// Verified testing
+			{
+				PreConfig: func() {
+					testAccServer.VerifySender("verification@example.com")
+				},
+				Config: config("2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_single_sender.test", "verified", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,390 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &subuserCreditsResource{}
+	_ resource.ResourceWithConfigure      = &subuserCreditsResource{}
+	_ resource.ResourceWithImportState    = &subuserCreditsResource{}
+	_ resource.ResourceWithValidateConfig = &subuserCreditsResource{}
+	_ resource.ResourceWithModifyPlan     = &subuserCreditsResource{}
+)
+
+func NewSubuserCreditsResource() resource.Resource {
+	return &subuserCreditsResource{}
+}
+
+type subuserCreditsResource struct {
+	client *sendgrid.Client
+}
+
+type SubuserCreditsResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Username        types.String `tfsdk:"username"`
+	Type            types.String `tfsdk:"type"`
+	Total           types.Int64  `tfsdk:"total"`
+	ResetFrequency  types.String `tfsdk:"reset_frequency"`
+	AdjustRemaining types.Int64  `tfsdk:"adjust_remaining"`
+	Remain          types.Int64  `tfsdk:"remain"`
+	Used            types.Int64  `tfsdk:"used"`
+}
+
+func (r *subuserCreditsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subuser_credits"
+}
+
+func (r *subuserCreditsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to limit the number of emails a subuser can send. Destroying the resource makes the credits of the subuser unlimited again",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The username of the subuser",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the subuser",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the credits: unlimited, recurring to reset the remaining credits to total at every reset_frequency, or nonrecurring to give total credits once",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sendgrid.SubuserCreditsUnlimited, sendgrid.SubuserCreditsRecurring, sendgrid.SubuserCreditsNonrecurring),
+				},
+			},
+			"total": schema.Int64Attribute{
+				Description: "The number of credits of the subuser. Required for recurring and nonrecurring credits. Changing it resets the remaining credits",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"reset_frequency": schema.StringAttribute{
+				Description: "How often the remaining credits are reset to total, daily, weekly or monthly. Required for recurring credits",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("daily", "weekly", "monthly"),
+				},
+			},
+			"adjust_remaining": schema.Int64Attribute{
+				Description: "Number of credits to add to the remaining credits, negative to remove credits, without changing total. " +
+					"It is a trigger rather than a target: the whole value is added when the resource is created and every time the value changes, " +
+					"so changing 100 to 150 adds 150 credits, and the plan warns with the exact amount. " +
+					"It is not applied again when a change of type, total or reset_frequency resets the remaining credits, and removing it adds nothing",
+				Optional: true,
+			},
+			"remain": schema.Int64Attribute{
+				Description: "The number of credits the subuser has left",
+				Computed:    true,
+			},
+			"used": schema.Int64Attribute{
+				Description: "The number of credits the subuser used",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that total, reset_frequency and adjust_remaining
+// are only set for the credit types using them.
+func (r *subuserCreditsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SubuserCreditsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() || config.Type.IsNull() {
+		return
+	}
+
+	creditsType := config.Type.ValueString()
+	var required, conflicting []string
+	switch creditsType {
+	case sendgrid.SubuserCreditsUnlimited:
+		conflicting = []string{"total", "reset_frequency", "adjust_remaining"}
+	case sendgrid.SubuserCreditsRecurring:
+		required = []string{"total", "reset_frequency"}
+	case sendgrid.SubuserCreditsNonrecurring:
+		required = []string{"total"}
+		conflicting = []string{"reset_frequency"}
+	default:
+		return
+	}
+
+	isNull := map[string]bool{
+		"total":            config.Total.IsNull(),
+		"reset_frequency":  config.ResetFrequency.IsNull(),
+		"adjust_remaining": config.AdjustRemaining.IsNull(),
+	}
+	for _, attr := range required {
+		if isNull[attr] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Missing subuser credits attribute",
+				fmt.Sprintf("%s is required for %s credits.", attr, creditsType),
+			)
+		}
+	}
+	for _, attr := range conflicting {
+		if !isNull[attr] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Conflicting subuser credits attribute",
+				fmt.Sprintf("%s cannot be set for %s credits.", attr, creditsType),
+			)
+		}
+	}
+}
+
+// adjustment returns the credits adjust_remaining adds when plan is applied
+// over state, a null state for a new resource. The whole value is added
+// whenever it changes.
+func (m SubuserCreditsResourceModel) adjustment(state *SubuserCreditsResourceModel) int64 {
+	if m.AdjustRemaining.IsNull() || m.AdjustRemaining.IsUnknown() {
+		return 0
+	}
+	if state != nil && m.AdjustRemaining.Equal(state.AdjustRemaining) {
+		return 0
+	}
+	return m.AdjustRemaining.ValueInt64()
+}
+
+// ModifyPlan warns about the credits adjust_remaining is going to add, the
+// diff of the attribute alone reads like a target value.
+func (r *subuserCreditsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SubuserCreditsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *SubuserCreditsResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &SubuserCreditsResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	amount := plan.adjustment(state)
+	switch {
+	case amount > 0:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("adjust_remaining"),
+			"Subuser credits adjustment",
+			fmt.Sprintf("%d credits will be added to the remaining credits of subuser %s.", amount, plan.Username.ValueString()),
+		)
+	case amount < 0:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("adjust_remaining"),
+			"Subuser credits adjustment",
+			fmt.Sprintf("%d credits will be removed from the remaining credits of subuser %s.", -amount, plan.Username.ValueString()),
+		)
+	}
+}
+
+// subuserCreditsState builds the state of the credits of username.
+// adjust_remaining is not part of the API and is taken from the caller.
+func subuserCreditsState(username string, credits *sendgrid.SubuserCredits, adjustRemaining types.Int64) SubuserCreditsResourceModel {
+	state := SubuserCreditsResourceModel{
+		ID:              types.StringValue(username),
+		Username:        types.StringValue(username),
+		Type:            types.StringValue(credits.Type),
+		Total:           types.Int64Null(),
+		ResetFrequency:  types.StringNull(),
+		AdjustRemaining: adjustRemaining,
+		Remain:          types.Int64Value(credits.Remain),
+		Used:            types.Int64Value(credits.Used),
+	}
+
+	if credits.Type != sendgrid.SubuserCreditsUnlimited {
+		state.Total = types.Int64Value(credits.Total)
+	}
+	if credits.ResetFrequency != "" {
+		state.ResetFrequency = types.StringValue(credits.ResetFrequency)
+	}
+
+	return state
+}
+
+func (r *subuserCreditsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SubuserCreditsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := plan.Username.ValueString()
+
+	credits, err := r.client.SetSubuserCredits(ctx, username, sendgrid.SubuserCredits{
+		Type:           plan.Type.ValueString(),
+		Total:          plan.Total.ValueInt64(),
+		ResetFrequency: plan.ResetFrequency.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error setting subuser credits", "Could not set subuser credits: ", err, "type", "total", "reset_frequency")
+		return
+	}
+
+	if amount := plan.adjustment(nil); amount != 0 {
+		credits, err = r.client.AdjustSubuserRemainingCredits(ctx, username, amount)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error adjusting subuser credits", "Could not adjust the remaining subuser credits: ", err, "adjust_remaining")
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Set subuser credits", map[string]any{"username": username, "type": credits.Type, "remain": credits.Remain})
+
+	diags = resp.State.Set(ctx, subuserCreditsState(username, credits, plan.AdjustRemaining))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subuserCreditsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SubuserCreditsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.ID.ValueString()
+
+	credits, err := r.client.ReadSubuserCredits(ctx, username)
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Subuser not found, removing from state", map[string]any{"username": username})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading subuser credits",
+			fmt.Sprintf("Could not read the credits of subuser %s: %s", username, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, subuserCreditsState(username, credits, state.AdjustRemaining))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only replaces the credit limit when it changed, as that resets the
+// remaining credits, and adds adjust_remaining when it changed.
+func (r *subuserCreditsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SubuserCreditsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.ID.ValueString()
+
+	var credits *sendgrid.SubuserCredits
+	var err error
+	if !plan.Type.Equal(state.Type) || !plan.Total.Equal(state.Total) || !plan.ResetFrequency.Equal(state.ResetFrequency) {
+		credits, err = r.client.SetSubuserCredits(ctx, username, sendgrid.SubuserCredits{
+			Type:           plan.Type.ValueString(),
+			Total:          plan.Total.ValueInt64(),
+			ResetFrequency: plan.ResetFrequency.ValueString(),
+		})
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error setting subuser credits", "Could not set subuser credits: ", err, "type", "total", "reset_frequency")
+			return
+		}
+	}
+
+	if amount := plan.adjustment(&state); amount != 0 {
+		credits, err = r.client.AdjustSubuserRemainingCredits(ctx, username, amount)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error adjusting subuser credits", "Could not adjust the remaining subuser credits: ", err, "adjust_remaining")
+			return
+		}
+	}
+
+	if credits == nil {
+		credits, err = r.client.ReadSubuserCredits(ctx, username)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading subuser credits",
+				fmt.Sprintf("Could not read the credits of subuser %s: %s", username, err),
+			)
+			return
+		}
+	}
+
+	diags := resp.State.Set(ctx, subuserCreditsState(username, credits, plan.AdjustRemaining))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subuserCreditsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SubuserCreditsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SetSubuserCredits(ctx, state.ID.ValueString(), sendgrid.SubuserCredits{Type: sendgrid.SubuserCreditsUnlimited})
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error resetting subuser credits",
+			fmt.Sprintf("Could not make the credits of subuser %s unlimited: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Reset subuser credits", map[string]any{"username": state.ID.ValueString()})
+}
+
+func (r *subuserCreditsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Parent()
+}
+
+func (r *subuserCreditsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,123 @@
+package sendgrid
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubuserCreditsResource(t *testing.T) {
+	subuser := `
+				resource "sendgrid_subuser" "test" {
+					email    = "credits@example.com"
+					username = "credits.test"
+					ips      = ["192.0.2.1"]
+					password = "C3|zh!%SR],jgD5d"
+				  }
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + subuser + `
+				resource "sendgrid_subuser_credits" "test" {
+					username = sendgrid_subuser.test.username
+					type     = "recurring"
+					total    = 1000
+				  }
+`,
+				ExpectError: regexp.MustCompile("reset_frequency is required for recurring credits"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + subuser + `
+				resource "sendgrid_subuser_credits" "test" {
+					username         = sendgrid_subuser.test.username
+					type             = "recurring"
+					total            = 1000
+					reset_frequency  = "monthly"
+					adjust_remaining = -100
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "id", "credits.test"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "total", "1000"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "remain", "900"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "used", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_subuser_credits.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// adjust_remaining is not part of the API.
+				ImportStateVerifyIgnore: []string{"adjust_remaining"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + subuser + `
+				resource "sendgrid_subuser_credits" "test" {
+					username         = sendgrid_subuser.test.username
+					type             = "recurring"
+					total            = 1000
+					reset_frequency  = "monthly"
+					adjust_remaining = 50
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "remain", "950"),
+				),
+			},
+			// Changing the limit resets the remaining credits, the adjustment is not applied again
+			{
+				Config: providerConfig + subuser + `
+				resource "sendgrid_subuser_credits" "test" {
+					username         = sendgrid_subuser.test.username
+					type             = "recurring"
+					total            = 2000
+					reset_frequency  = "monthly"
+					adjust_remaining = 50
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "total", "2000"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "remain", "2000"),
+				),
+			},
+			// A new value is added as a whole, not as the difference to the previous one
+			{
+				Config: providerConfig + subuser + `
+				resource "sendgrid_subuser_credits" "test" {
+					username         = sendgrid_subuser.test.username
+					type             = "recurring"
+					total            = 2000
+					reset_frequency  = "monthly"
+					adjust_remaining = 150
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "remain", "2150"),
+				),
+			},
+			{
+				Config: providerConfig + subuser + `
+				resource "sendgrid_subuser_credits" "test" {
+					username = sendgrid_subuser.test.username
+					type     = "nonrecurring"
+					total    = 500
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "type", "nonrecurring"),
+					resource.TestCheckNoResourceAttr("sendgrid_subuser_credits.test", "reset_frequency"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.test", "remain", "500"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,196 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &subuserIPsResource{}
+	_ resource.ResourceWithConfigure   = &subuserIPsResource{}
+	_ resource.ResourceWithImportState = &subuserIPsResource{}
+)
+
+func NewSubuserIPsResource() resource.Resource {
+	return &subuserIPsResource{}
+}
+
+type subuserIPsResource struct {
+	client *sendgrid.Client
+}
+
+type SubuserIPsResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	IPs      []string     `tfsdk:"ips"`
+}
+
+func (r *subuserIPsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subuser_ips"
+}
+
+func (r *subuserIPsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage the full list of dedicated IPs a subuser can send from. IPs added outside of Terraform are removed. Destroying the resource leaves the IPs of the subuser unchanged, a subuser needs at least one IP",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The username of the subuser",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the subuser",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ips": schema.SetAttribute{
+				Description: "The IPs the subuser can send from. They must be assigned to the parent account",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *subuserIPsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SubuserIPsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ips, err := r.client.SetSubuserIPs(ctx, plan.Username.ValueString(), plan.IPs)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error setting subuser IPs", "Could not set subuser IPs: ", err, "ips")
+		return
+	}
+
+	tflog.Debug(ctx, "Set subuser IPs", map[string]any{"username": plan.Username.ValueString(), "ips": ips})
+
+	plan.ID = plan.Username
+	plan.IPs = ips
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read takes the IPs from the IPs endpoint, so that IPs assigned to the
+// subuser elsewhere show up as drift.
+func (r *subuserIPsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SubuserIPsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.ID.ValueString()
+
+	// the IPs endpoint lists no IPs for a missing subuser rather than failing
+	_, err := r.client.ReadSubuser(ctx, username)
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Subuser not found, removing from state", map[string]any{"username": username})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading subuser",
+			fmt.Sprintf("Could not read subuser %s: %s", username, err),
+		)
+		return
+	}
+
+	ips, err := r.client.ReadSubuserIPs(ctx, username)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading subuser IPs",
+			fmt.Sprintf("Could not read the IPs of subuser %s: %s", username, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, SubuserIPsResourceModel{
+		ID:       types.StringValue(username),
+		Username: types.StringValue(username),
+		IPs:      ips,
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subuserIPsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SubuserIPsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ips, err := r.client.SetSubuserIPs(ctx, state.ID.ValueString(), plan.IPs)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error setting subuser IPs", "Could not set subuser IPs: ", err, "ips")
+		return
+	}
+
+	plan.ID = state.ID
+	plan.IPs = ips
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only forgets the IPs, SendGrid does not allow a subuser without IPs.
+func (r *subuserIPsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SubuserIPsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Left subuser IPs unchanged", map[string]any{"username": state.ID.ValueString()})
+}
+
+func (r *subuserIPsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Parent()
+}
+
+func (r *subuserIPsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,63 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The IPs are dedicated IPs of the account of the fake, see
+// sendgridtest.DedicatedIPs.
+func TestAccSubuserIPsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_subuser" "test" {
+					email    = "ips@example.com"
+					username = "ips.test"
+					ips      = ["192.0.2.1"]
+					password = "C3|zh!%SR],jgD5d"
+				  }
+
+				resource "sendgrid_subuser_ips" "test" {
+					username = sendgrid_subuser.test.username
+					ips      = ["192.0.2.1", "192.0.2.2"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_ips.test", "id", "ips.test"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_ips.test", "ips.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_subuser_ips.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_subuser" "test" {
+					email    = "ips@example.com"
+					username = "ips.test"
+					ips      = ["192.0.2.1"]
+					password = "C3|zh!%SR],jgD5d"
+				  }
+
+				resource "sendgrid_subuser_ips" "test" {
+					username = sendgrid_subuser.test.username
+					ips      = ["192.0.2.3"]
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_ips.test", "ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_subuser_ips.test", "ips.*", "192.0.2.3"),
+				),
+			},
+		},
+	})
+}
@@ -51,7 +51,7 @@ func (r *subuserResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	r.client = client
+	r.client = client.Parent()
 }
 
 func (r *subuserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -83,7 +83,7 @@ func (r *subuserResource) Schema(_ context.Context, req resource.SchemaRequest,
 				//	Sensitive:   true,
 			},
 			"ips": schema.ListAttribute{
-				Description: "Scopes of the subuser",
+				Description: "IPs of the subuser at creation. Changes are not applied, manage the IPs with sendgrid_subuser_ips instead",
 				Required:    true,
 				ElementType: types.StringType,
 			},
@@ -124,10 +124,7 @@ func (r *subuserResource) Create(ctx context.Context, req resource.CreateRequest
 
 	subuserrespBody, err := r.client.CreateSubuser(ctx, item)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to create subuser",
-			fmt.Sprintf("Unable to create subuser: %s", err),
-		)
+		addClientError(&resp.Diagnostics, "Unable to create subuser", "Unable to create subuser: ", err, "username", "email", "password", "ips")
 		return
 	}
 
@@ -168,6 +165,12 @@ func (r *subuserResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	subuserrespBody, err := r.client.GetSubuser(ctx, getitem)
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Subuser not found, removing from state", map[string]any{"username": readstate.Username.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Unable to read subuser",
 			fmt.Sprintf("Unable to read subuser: %s", err),
@@ -210,10 +213,7 @@ func (r *subuserResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	subuserrespBody, err := r.client.UpdateSubuser(ctx, item)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to update subuser",
-			fmt.Sprintf("Unable to update subuser: %s", err),
-		)
+		addClientError(&resp.Diagnostics, "Unable to update subuser", "Unable to update subuser: ", err, "disabled")
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccsubuserResource(t *testing.T) {
@@ -14,18 +15,14 @@ func TestAccsubuserResource(t *testing.T) {
 			{
 				Config: providerConfig + `
 				resource "sendgrid_subuser" "test" {
-					email      = "sk@example.com"
-					username   = "sk.test"
-					ips = [
-					  "" # your domain ip. you can get this from sendgrid dashboard.
-					]
-					password   = "C3|zh!%SR],jgD5d"
-					disabled   = false # if you want to disable this subuser then change this value to true.
+					email    = "sk@example.com"
+					username = "sk.test"
+					ips      = ["192.0.2.1"]
+					password = "C3|zh!%SR],jgD5d"
+					disabled = false
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify number of items
-					// Verify first order item
 					resource.TestCheckResourceAttr("sendgrid_subuser.test", "email", "sk@example.com"),
 					resource.TestCheckResourceAttr("sendgrid_subuser.test", "username", "sk.test"),
 					resource.TestCheckResourceAttr("sendgrid_subuser.test", "password", "C3|zh!%SR],jgD5d"),
@@ -36,32 +33,32 @@ func TestAccsubuserResource(t *testing.T) {
 			},
 			// ImportState testing
 			{
-				ResourceName:      "sendgrid_subuser.test",
-				ImportState:       true,
-				ImportStateVerify: true,
-				// The last_updated attribute does not exist in the sendgrid
-				// API, therefore there is no value for it during import.
-				//				ImportStateVerifyIgnore: []string{"last_updated"},
+				ResourceName:                         "sendgrid_subuser.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "username",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["sendgrid_subuser.test"].Primary.Attributes["username"], nil
+				},
+				// The API never returns the password, the IPs are only used
+				// at creation.
+				ImportStateVerifyIgnore: []string{"password", "ips"},
 			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
 				resource "sendgrid_subuser" "test" {
-					email      = "sk@example.com"
-					username   = "chid.test"
-					ips = [
-					  "" # your domain ip. you can get this from sendgrid dashboard.
-					]
-					password   = "C3|zh!%SR],jgD5d"
-					disabled   = false # if you want to disable this subuser then change this value to true.
+					email    = "sk@example.com"
+					username = "sk.test"
+					ips      = ["192.0.2.1"]
+					password = "C3|zh!%SR],jgD5d"
+					disabled = true
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify first order item updated
 					resource.TestCheckResourceAttr("sendgrid_subuser.test", "email", "sk@example.com"),
 					resource.TestCheckResourceAttr("sendgrid_subuser.test", "username", "sk.test"),
-					resource.TestCheckResourceAttr("sendgrid_subuser.test", "password", "C3|zh!%SR],jgD5d"),
-					resource.TestCheckResourceAttr("sendgrid_subuser.test", "disabled", "false"),
+					resource.TestCheckResourceAttr("sendgrid_subuser.test", "disabled", "true"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("sendgrid_subuser.test", "id"),
 				),
@@ -0,0 +1,66 @@
+package sendgrid
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// suppressedEmailsAttribute is the schema of the emails managed by the
+// suppression resources.
+func suppressedEmailsAttribute(description string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Description: description,
+		Required:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[^@\s]+@[^@\s]+$`), "must be an email address"),
+			),
+		},
+	}
+}
+
+// diffEmails returns the emails to add and to remove to go from current to
+// planned, so that changing one address does not rewrite the whole list.
+// SendGrid compares emails case-insensitively.
+func diffEmails(current, planned []string) (added, removed []string) {
+	for _, email := range planned {
+		if !containsEmail(current, email) {
+			added = append(added, email)
+		}
+	}
+	for _, email := range current {
+		if !containsEmail(planned, email) {
+			removed = append(removed, email)
+		}
+	}
+	return added, removed
+}
+
+// keepEmails returns the emails of managed that are present in found,
+// keeping the spelling used in the configuration.
+func keepEmails(managed, found []string) []string {
+	kept := []string{}
+	for _, email := range managed {
+		if containsEmail(found, email) {
+			kept = append(kept, email)
+		}
+	}
+	return kept
+}
+
+func containsEmail(emails []string, email string) bool {
+	for _, e := range emails {
+		if strings.EqualFold(e, email) {
+			return true
+		}
+	}
+	return false
+}
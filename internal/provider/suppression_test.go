@@ -0,0 +1,19 @@
+package sendgrid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffEmails(t *testing.T) {
+	added, removed := diffEmails(
+		[]string{"a@example.com", "B@example.com", "c@example.com"},
+		[]string{"b@example.com", "c@example.com", "d@example.com"},
+	)
+	if !reflect.DeepEqual(added, []string{"d@example.com"}) {
+		t.Errorf("unexpected added emails: %v", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"a@example.com"}) {
+		t.Errorf("unexpected removed emails: %v", removed)
+	}
+}
@@ -104,5 +104,5 @@ func (d *teammateDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = client
+	d.client = client.Parent()
 }
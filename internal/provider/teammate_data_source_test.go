@@ -6,48 +6,43 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+// TestAccteammateDataSource needs the fake to accept the invite, the data
+// source only finds active teammates.
 func TestAccteammateDataSource(t *testing.T) {
+	if testAccServer == nil {
+		t.Skip("requires the fake SendGrid API")
+	}
+
+	teammate := `
+				resource "sendgrid_teammate" "test" {
+					email    = "data@example.com"
+					is_admin = false
+				  }
+`
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Create and Read testing
-			{
-				Config: providerConfig + `
-				data "sendgrid_teammate" "test" {
-					email = "yourname@example.com"
-				  }
-`,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify number of items
-					// Verify first order item
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "email", "sk@example.com"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "username", "sk.test"),
-				),
-			},
-			// ImportState testing
 			{
-				ResourceName:      "sendgrid_teammate.test",
-				ImportState:       true,
-				ImportStateVerify: true,
-				// The last_updated attribute does not exist in the sendgrid
-				// API, therefore there is no value for it during import.
-				//				ImportStateVerifyIgnore: []string{"last_updated"},
+				Config: providerConfig + teammate,
 			},
-			// Update and Read testing
+			// Read testing
 			{
-				Config: providerConfig + `
+				PreConfig: func() {
+					if _, err := testAccServer.AcceptInvite("data@example.com"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: providerConfig + teammate + `
 				data "sendgrid_teammate" "test" {
-					email = "yourname@example.com"
-					is_admin = false
+					email = sendgrid_teammate.test.email
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify first order item updated
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "email", "sk@example.com"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "username", "sk.test"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate.test", "email", "data@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_teammate.test", "username", "data"),
 				),
 			},
-			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
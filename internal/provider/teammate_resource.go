@@ -158,7 +158,7 @@ func (r *teammateResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = client
+	r.client = client.Parent()
 }
 
 func (r *teammateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -192,10 +192,7 @@ func (r *teammateResource) Create(ctx context.Context, req resource.CreateReques
 
 	teammaterespBody, err := r.client.CreateTeammate(ctx, teammateitem)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected error",
-			fmt.Sprintf("Unable to create teammate: %s", err),
-		)
+		addClientError(&resp.Diagnostics, "Unexpected error", "Unable to create teammate: ", err, "email", "is_admin")
 		return
 	}
 
@@ -251,6 +248,12 @@ func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	teammaterespBody, err := r.client.RefreshTeammate(ctx, readstate.Email.ValueString())
 	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Teammate not found, removing from state", map[string]any{"email": readstate.Email.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Unexpected error",
 			fmt.Sprintf("Unable to refresh teammate: %s", err),
@@ -320,10 +323,7 @@ func (r *teammateResource) Update(ctx context.Context, req resource.UpdateReques
 
 	updatetmaterespBody, err := r.client.UpdateTeammate(ctx, teammateitem)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected error",
-			fmt.Sprintf("Unable to update teammate: %s", err),
-		)
+		addClientError(&resp.Diagnostics, "Unexpected error", "Unable to update teammate: ", err, "is_admin")
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccteammateResource(t *testing.T) {
@@ -13,57 +14,78 @@ func TestAccteammateResource(t *testing.T) {
 			// Create and Read testing
 			{
 				Config: providerConfig + `
-				resource "sendgrid_teammate" "name" {
-					email = "yourname@example.com"
+				resource "sendgrid_teammate" "test" {
+					email    = "invite@example.com"
 					is_admin = false
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify number of items
-					// Verify first order item
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "email", "sk@example.com"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "username", "sk.test"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "first_name", "sk"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "last_name", "Test"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.test", "email", "invite@example.com"),
 					resource.TestCheckResourceAttr("sendgrid_teammate.test", "is_admin", "false"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "is_read_only", "false"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "expiration_date", "none"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "user_type", "teammate"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.test", "username", ""),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("sendgrid_teammate.test", "token"),
+					resource.TestCheckResourceAttrSet("sendgrid_teammate.test", "expiration_date"),
 				),
 			},
 			// ImportState testing
 			{
-				ResourceName:      "sendgrid_teammate.test",
-				ImportState:       true,
-				ImportStateVerify: true,
-				// The last_updated attribute does not exist in the sendgrid
-				// API, therefore there is no value for it during import.
-				//				ImportStateVerifyIgnore: []string{"last_updated"},
+				ResourceName:                         "sendgrid_teammate.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["sendgrid_teammate.test"].Primary.Attributes["email"], nil
+				},
 			},
-			// Update and Read testing
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// TestAccteammateResourceAccepted needs the fake to accept the invite, nobody
+// follows the invitation email during live runs.
+func TestAccteammateResourceAccepted(t *testing.T) {
+	if testAccServer == nil {
+		t.Skip("requires the fake SendGrid API")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
 			{
 				Config: providerConfig + `
-				resource "sendgrid_teammate" "name" {
-					email = "yourname@example.com"
+				resource "sendgrid_teammate" "test" {
+					email    = "accepted@example.com"
 					is_admin = false
 				  }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify first order item updated
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "email", "sk@example.com"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "username", "sk.test"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "first_name", "sk"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "last_name", "Test"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "is_admin", "false"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "is_read_only", "false"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "expiration_date", "none"),
-					resource.TestCheckResourceAttr("sendgrid_teammate.test", "user_type", "teammate"),
-					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("sendgrid_teammate.test", "token"),
 				),
 			},
+			// Update and Read testing
+			{
+				PreConfig: func() {
+					if _, err := testAccServer.AcceptInvite("accepted@example.com"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: providerConfig + `
+				resource "sendgrid_teammate" "test" {
+					email    = "accepted@example.com"
+					is_admin = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.test", "email", "accepted@example.com"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.test", "username", "accepted"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.test", "is_admin", "true"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.test", "user_type", "admin"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.test", "token", ""),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
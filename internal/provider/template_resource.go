@@ -0,0 +1,209 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &templateResource{}
+	_ resource.ResourceWithConfigure   = &templateResource{}
+	_ resource.ResourceWithImportState = &templateResource{}
+)
+
+func NewTemplateResource() resource.Resource {
+	return &templateResource{}
+}
+
+type templateResource struct {
+	client *sendgrid.Client
+}
+
+type TemplateResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Generation types.String `tfsdk:"generation"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *templateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template"
+}
+
+func (r *templateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage transactional templates. The content of a template is managed with sendgrid_template_version",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the template",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the template",
+				Required:    true,
+			},
+			"generation": schema.StringAttribute{
+				Description: "The generation of the template, dynamic or legacy. Defaults to dynamic",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(sendgrid.TemplateGenerationDynamic),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{sendgrid.TemplateGenerationDynamic, sendgrid.TemplateGenerationLegacy}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The time the template was last updated",
+				Computed:    true,
+			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func templateState(template *sendgrid.Template, onBehalfOf types.String) TemplateResourceModel {
+	return TemplateResourceModel{
+		ID:         types.StringValue(template.ID),
+		Name:       types.StringValue(template.Name),
+		Generation: types.StringValue(template.Generation),
+		UpdatedAt:  types.StringValue(template.UpdatedAt),
+		OnBehalfOf: onBehalfOf,
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TemplateResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template, err := clientFor(r.client, plan.OnBehalfOf).CreateTemplate(ctx, sendgrid.Template{
+		Name:       plan.Name.ValueString(),
+		Generation: plan.Generation.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating template", "Could not create template: ", err, "name", "generation")
+		return
+	}
+
+	tflog.Debug(ctx, "Created template", map[string]any{"id": template.ID})
+
+	diags = resp.State.Set(ctx, templateState(template, plan.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *templateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TemplateResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template, err := clientFor(r.client, state.OnBehalfOf).ReadTemplate(ctx, state.ID.ValueString())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Template not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading template",
+			fmt.Sprintf("Could not read template %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, templateState(template, state.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *templateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TemplateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template, err := clientFor(r.client, state.OnBehalfOf).UpdateTemplate(ctx, sendgrid.Template{
+		ID:   state.ID.ValueString(),
+		Name: plan.Name.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating template", "Could not update template: ", err, "name")
+		return
+	}
+
+	diags := resp.State.Set(ctx, templateState(template, state.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TemplateResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := clientFor(r.client, state.OnBehalfOf).DeleteTemplate(ctx, state.ID.ValueString())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting template",
+			fmt.Sprintf("Could not delete template %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted template", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *templateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *templateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	onBehalfOf, id := splitOnBehalfOfImportID(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
+}
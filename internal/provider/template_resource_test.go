@@ -0,0 +1,48 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcctemplateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_template" "test" {
+					name = "welcome"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template.test", "name", "welcome"),
+					resource.TestCheckResourceAttr("sendgrid_template.test", "generation", "dynamic"),
+					resource.TestCheckResourceAttrSet("sendgrid_template.test", "id"),
+					resource.TestCheckResourceAttrSet("sendgrid_template.test", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_template" "test" {
+					name = "welcome-renamed"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template.test", "name", "welcome-renamed"),
+					resource.TestCheckResourceAttr("sendgrid_template.test", "generation", "dynamic"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
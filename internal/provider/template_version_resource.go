@@ -0,0 +1,380 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &templateVersionResource{}
+	_ resource.ResourceWithConfigure      = &templateVersionResource{}
+	_ resource.ResourceWithImportState    = &templateVersionResource{}
+	_ resource.ResourceWithValidateConfig = &templateVersionResource{}
+)
+
+func NewTemplateVersionResource() resource.Resource {
+	return &templateVersionResource{}
+}
+
+type templateVersionResource struct {
+	client *sendgrid.Client
+}
+
+type TemplateVersionResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	TemplateID           types.String `tfsdk:"template_id"`
+	Name                 types.String `tfsdk:"name"`
+	Subject              types.String `tfsdk:"subject"`
+	HTMLContent          types.String `tfsdk:"html_content"`
+	PlainContent         types.String `tfsdk:"plain_content"`
+	GeneratePlainContent types.Bool   `tfsdk:"generate_plain_content"`
+	Active               types.Bool   `tfsdk:"active"`
+	Editor               types.String `tfsdk:"editor"`
+	TestData             types.String `tfsdk:"test_data"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+	OnBehalfOf           types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *templateVersionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_version"
+}
+
+func (r *templateVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage the versions of a transactional template",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the template version",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Description: "The ID of the template the version belongs to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the template version",
+				Required:    true,
+			},
+			"subject": schema.StringAttribute{
+				Description: "The subject of the mail, may contain substitutions like {{name}}",
+				Required:    true,
+			},
+			"html_content": schema.StringAttribute{
+				Description: "The HTML content of the mail",
+				Optional:    true,
+			},
+			"plain_content": schema.StringAttribute{
+				Description: "The plain text content of the mail. Generated from html_content unless generate_plain_content is false",
+				Optional:    true,
+				Computed:    true,
+			},
+			"generate_plain_content": schema.BoolAttribute{
+				Description: "Whether the plain text content is generated from html_content. Defaults to true",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether this is the active version of the template. Setting it to true activates this version, which deactivates the version that was active before. It cannot be set to false, as a version is only deactivated by activating another one. The first version of a template is always active",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"editor": schema.StringAttribute{
+				Description: "The editor used in the UI, code or design. Defaults to code",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("code"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"code", "design"}...),
+				},
+			},
+			"test_data": schema.StringAttribute{
+				Description: "JSON encoded data used to preview dynamic templates in the UI",
+				Optional:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The time the template version was last updated",
+				Computed:    true,
+			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func (r *templateVersionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TemplateVersionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Active.IsNull() && !config.Active.IsUnknown() && !config.Active.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("active"),
+			"Invalid template version active flag",
+			"active cannot be false, SendGrid can only deactivate a version by activating another version of the template. Remove the attribute instead.",
+		)
+	}
+
+	if config.PlainContent.IsNull() || config.PlainContent.IsUnknown() || config.GeneratePlainContent.IsUnknown() {
+		return
+	}
+
+	if config.GeneratePlainContent.IsNull() || config.GeneratePlainContent.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("plain_content"),
+			"Conflicting template version content",
+			"plain_content can only be set when generate_plain_content is false, otherwise it is generated from html_content.",
+		)
+	}
+}
+
+// templateVersionState builds the state of version. active is taken from
+// the plan when it is known, as another version activated in the same apply
+// only deactivates this one on the next refresh.
+func templateVersionState(version *sendgrid.TemplateVersion, plan TemplateVersionResourceModel) TemplateVersionResourceModel {
+	state := TemplateVersionResourceModel{
+		ID:                   types.StringValue(version.ID),
+		TemplateID:           types.StringValue(version.TemplateID),
+		Name:                 types.StringValue(version.Name),
+		Subject:              types.StringValue(version.Subject),
+		HTMLContent:          types.StringNull(),
+		PlainContent:         types.StringValue(version.PlainContent),
+		GeneratePlainContent: types.BoolValue(version.GeneratePlainContent),
+		Active:               types.BoolValue(version.Active == 1),
+		Editor:               types.StringValue(version.Editor),
+		TestData:             types.StringNull(),
+		UpdatedAt:            types.StringValue(version.UpdatedAt),
+		OnBehalfOf:           plan.OnBehalfOf,
+	}
+
+	if version.HTMLContent != "" {
+		state.HTMLContent = types.StringValue(version.HTMLContent)
+	}
+	if version.TestData != "" {
+		state.TestData = types.StringValue(version.TestData)
+	}
+	if !plan.Active.IsNull() && !plan.Active.IsUnknown() {
+		state.Active = plan.Active
+	}
+
+	return state
+}
+
+func templateVersionFromPlan(plan TemplateVersionResourceModel) sendgrid.TemplateVersion {
+	version := sendgrid.TemplateVersion{
+		TemplateID:           plan.TemplateID.ValueString(),
+		Name:                 plan.Name.ValueString(),
+		Subject:              plan.Subject.ValueString(),
+		HTMLContent:          plan.HTMLContent.ValueString(),
+		GeneratePlainContent: plan.GeneratePlainContent.ValueBool(),
+		Editor:               plan.Editor.ValueString(),
+		TestData:             plan.TestData.ValueString(),
+	}
+
+	if !plan.PlainContent.IsUnknown() {
+		version.PlainContent = plan.PlainContent.ValueString()
+	}
+
+	return version
+}
+
+var templateVersionAttributes = []string{"name", "subject", "html_content", "plain_content", "editor", "test_data"}
+
+func (r *templateVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TemplateVersionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	version, err := clientFor(r.client, plan.OnBehalfOf).CreateTemplateVersion(ctx, templateVersionFromPlan(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating template version", "Could not create template version: ", err, templateVersionAttributes...)
+		return
+	}
+
+	tflog.Debug(ctx, "Created template version", map[string]any{"template_id": version.TemplateID, "id": version.ID})
+
+	version, err = r.activate(ctx, plan, version)
+	if err != nil {
+		// the version exists, store it so the activation is retried on the next apply
+		plan.Active = types.BoolNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, templateVersionState(version, plan))...)
+		resp.Diagnostics.AddError(
+			"Error activating template version",
+			fmt.Sprintf("Could not activate template version %s: %s", version.ID, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, templateVersionState(version, plan))
+	resp.Diagnostics.Append(diags...)
+}
+
+// activate makes version the active version of its template when the plan
+// asks for it and it is not active yet.
+func (r *templateVersionResource) activate(ctx context.Context, plan TemplateVersionResourceModel, version *sendgrid.TemplateVersion) (*sendgrid.TemplateVersion, error) {
+	if !plan.Active.ValueBool() || version.Active == 1 {
+		return version, nil
+	}
+
+	activated, err := clientFor(r.client, plan.OnBehalfOf).ActivateTemplateVersion(ctx, version.TemplateID, version.ID)
+	if err != nil {
+		return version, err
+	}
+
+	tflog.Debug(ctx, "Activated template version", map[string]any{"template_id": activated.TemplateID, "id": activated.ID})
+
+	return activated, nil
+}
+
+func (r *templateVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TemplateVersionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	version, err := clientFor(r.client, state.OnBehalfOf).ReadTemplateVersion(ctx, state.TemplateID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Template version not found, removing from state", map[string]any{
+				"template_id": state.TemplateID.ValueString(),
+				"id":          state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading template version",
+			fmt.Sprintf("Could not read template version %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// refresh reports the actual active flag
+	state.Active = types.BoolNull()
+
+	diags = resp.State.Set(ctx, templateVersionState(version, state))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *templateVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TemplateVersionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item := templateVersionFromPlan(plan)
+	item.ID = state.ID.ValueString()
+
+	version, err := clientFor(r.client, state.OnBehalfOf).UpdateTemplateVersion(ctx, item)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating template version", "Could not update template version: ", err, templateVersionAttributes...)
+		return
+	}
+
+	version, err = r.activate(ctx, plan, version)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error activating template version",
+			fmt.Sprintf("Could not activate template version %s: %s", version.ID, err),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, templateVersionState(version, plan))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *templateVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TemplateVersionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := clientFor(r.client, state.OnBehalfOf).DeleteTemplateVersion(ctx, state.TemplateID.ValueString(), state.ID.ValueString())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting template version",
+			fmt.Sprintf("Could not delete template version %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted template version", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *templateVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState accepts <template_id>/<version_id>, optionally prefixed with
+// the subuser as in <subuser>,<template_id>/<version_id>.
+func (r *templateVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	onBehalfOf, importID := splitOnBehalfOfImportID(req.ID)
+
+	templateID, versionID, found := strings.Cut(importID, "/")
+	if !found || templateID == "" || versionID == "" {
+		resp.Diagnostics.AddError(
+			"Error importing template version",
+			fmt.Sprintf("Expected an import ID of the form template_id/version_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_id"), templateID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), versionID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
+}
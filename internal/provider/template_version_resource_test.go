@@ -0,0 +1,127 @@
+package sendgrid
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAcctemplateVersionResource(t *testing.T) {
+	versions := `
+				resource "sendgrid_template" "test" {
+				  name = "welcome"
+				}
+
+				resource "sendgrid_template_version" "test" {
+				  template_id  = sendgrid_template.test.id
+				  name         = "v1"
+				  subject      = "Hello {{name}}"
+				  html_content = "<p>Hi {{name}}</p>"
+				  test_data    = jsonencode({ name = "Jane" })
+				}
+
+				resource "sendgrid_template_version" "second" {
+				  template_id  = sendgrid_template.test.id
+				  name         = "v2"
+				  subject      = "Hello again {{name}}"
+				  html_content = "<p>Hi again {{name}}</p>"
+				  active       = true
+				}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// active can only be used to activate a version
+			{
+				Config: providerConfig + `
+				resource "sendgrid_template_version" "test" {
+					template_id = "d-0000"
+					name        = "v1"
+					subject     = "Welcome"
+					active      = false
+				  }
+`,
+				ExpectError: regexp.MustCompile("active cannot be false"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_template" "test" {
+					name = "welcome"
+				  }
+
+				resource "sendgrid_template_version" "test" {
+					template_id  = sendgrid_template.test.id
+					name         = "v1"
+					subject      = "Welcome {{name}}"
+					html_content = "<p>Hello {{name}}</p>"
+					active       = true
+					test_data    = jsonencode({ name = "Jane" })
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sendgrid_template_version.test", "template_id", "sendgrid_template.test", "id"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "subject", "Welcome {{name}}"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "plain_content", "Hello {{name}}"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "generate_plain_content", "true"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "active", "true"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "editor", "code"),
+					resource.TestCheckResourceAttrSet("sendgrid_template_version.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_template_version.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["sendgrid_template_version.test"]
+					if !ok {
+						return "", fmt.Errorf("sendgrid_template_version.test not found in state")
+					}
+					return rs.Primary.Attributes["template_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_template" "test" {
+					name = "welcome"
+				  }
+
+				resource "sendgrid_template_version" "test" {
+					template_id  = sendgrid_template.test.id
+					name         = "v1"
+					subject      = "Hello {{name}}"
+					html_content = "<p>Hi {{name}}</p>"
+					active       = true
+					test_data    = jsonencode({ name = "Jane" })
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "subject", "Hello {{name}}"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "plain_content", "Hi {{name}}"),
+				),
+			},
+			// Activating a second version deactivates the first without drift
+			{
+				Config: providerConfig + versions,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template_version.second", "active", "true"),
+				),
+			},
+			{
+				Config: providerConfig + versions,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "active", "false"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.second", "active", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,245 @@
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TrackingSettingClickResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	EnableText types.Bool   `tfsdk:"enable_text"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func NewTrackingSettingClickResource() resource.Resource {
+	const name = "tracking_settings_click"
+
+	state := func(setting *sendgrid.TrackingSettingClick, prior TrackingSettingClickResourceModel) TrackingSettingClickResourceModel {
+		return TrackingSettingClickResourceModel{
+			ID:         types.StringValue(name),
+			Enabled:    types.BoolValue(setting.Enabled),
+			EnableText: types.BoolValue(setting.EnableText),
+			OnBehalfOf: prior.OnBehalfOf,
+		}
+	}
+
+	return &settingResource[TrackingSettingClickResourceModel]{
+		name:        name,
+		description: "Resource to track the clicks on links of emails",
+		attributes: map[string]schema.Attribute{
+			"enabled": settingEnabledAttribute(),
+			"enable_text": schema.BoolAttribute{
+				Description: "Whether to track clicks in the plain text content of emails too. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+		read: func(ctx context.Context, client *sendgrid.Client, prior TrackingSettingClickResourceModel) (TrackingSettingClickResourceModel, error) {
+			setting, err := client.ReadTrackingSettingClick(ctx)
+			if err != nil {
+				return prior, err
+			}
+			return state(setting, prior), nil
+		},
+		update: func(ctx context.Context, client *sendgrid.Client, plan TrackingSettingClickResourceModel) (TrackingSettingClickResourceModel, error) {
+			setting, err := client.UpdateTrackingSettingClick(ctx, sendgrid.TrackingSettingClick{
+				Enabled:    plan.Enabled.ValueBool(),
+				EnableText: plan.EnableText.ValueBool(),
+			})
+			if err != nil {
+				return plan, err
+			}
+			return state(setting, plan), nil
+		},
+		disable: func(ctx context.Context, client *sendgrid.Client) error {
+			_, err := client.UpdateTrackingSettingClick(ctx, sendgrid.TrackingSettingClick{})
+			return err
+		},
+	}
+}
+
+type TrackingSettingOpenResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+}
+
+func NewTrackingSettingOpenResource() resource.Resource {
+	const name = "tracking_settings_open"
+
+	state := func(setting *sendgrid.TrackingSettingOpen, prior TrackingSettingOpenResourceModel) TrackingSettingOpenResourceModel {
+		return TrackingSettingOpenResourceModel{
+			ID:         types.StringValue(name),
+			Enabled:    types.BoolValue(setting.Enabled),
+			OnBehalfOf: prior.OnBehalfOf,
+		}
+	}
+
+	return &settingResource[TrackingSettingOpenResourceModel]{
+		name:        name,
+		description: "Resource to track the opens of emails with an invisible image",
+		attributes: map[string]schema.Attribute{
+			"enabled": settingEnabledAttribute(),
+		},
+		read: func(ctx context.Context, client *sendgrid.Client, prior TrackingSettingOpenResourceModel) (TrackingSettingOpenResourceModel, error) {
+			setting, err := client.ReadTrackingSettingOpen(ctx)
+			if err != nil {
+				return prior, err
+			}
+			return state(setting, prior), nil
+		},
+		update: func(ctx context.Context, client *sendgrid.Client, plan TrackingSettingOpenResourceModel) (TrackingSettingOpenResourceModel, error) {
+			setting, err := client.UpdateTrackingSettingOpen(ctx, sendgrid.TrackingSettingOpen{
+				Enabled: plan.Enabled.ValueBool(),
+			})
+			if err != nil {
+				return plan, err
+			}
+			return state(setting, plan), nil
+		},
+		disable: func(ctx context.Context, client *sendgrid.Client) error {
+			_, err := client.UpdateTrackingSettingOpen(ctx, sendgrid.TrackingSettingOpen{})
+			return err
+		},
+	}
+}
+
+type TrackingSettingSubscriptionResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	HTMLContent  types.String `tfsdk:"html_content"`
+	PlainContent types.String `tfsdk:"plain_content"`
+	Landing      types.String `tfsdk:"landing"`
+	Replace      types.String `tfsdk:"replace"`
+	URL          types.String `tfsdk:"url"`
+	OnBehalfOf   types.String `tfsdk:"on_behalf_of"`
+}
+
+func NewTrackingSettingSubscriptionResource() resource.Resource {
+	const name = "tracking_settings_subscription"
+
+	state := func(setting *sendgrid.TrackingSettingSubscription, prior TrackingSettingSubscriptionResourceModel) TrackingSettingSubscriptionResourceModel {
+		return TrackingSettingSubscriptionResourceModel{
+			ID:           types.StringValue(name),
+			Enabled:      types.BoolValue(setting.Enabled),
+			HTMLContent:  types.StringValue(setting.HTMLContent),
+			PlainContent: types.StringValue(setting.PlainContent),
+			Landing:      types.StringValue(setting.Landing),
+			Replace:      types.StringValue(setting.Replace),
+			URL:          types.StringValue(setting.URL),
+			OnBehalfOf:   prior.OnBehalfOf,
+		}
+	}
+
+	return &settingResource[TrackingSettingSubscriptionResourceModel]{
+		name:        name,
+		description: "Resource to add an unsubscribe link to every email",
+		attributes: map[string]schema.Attribute{
+			"enabled":       settingEnabledAttribute(),
+			"html_content":  settingStringAttribute("The HTML appended to emails, the <% %> tag is replaced by the unsubscribe link"),
+			"plain_content": settingStringAttribute("The text appended to plain text emails, the <% %> tag is replaced by the unsubscribe link"),
+			"landing":       settingStringAttribute("The HTML of the page shown after unsubscribing"),
+			"replace":       settingStringAttribute("A tag that is replaced by the unsubscribe URL, for example [unsubscribe]. Emails containing the tag get no unsubscribe link appended"),
+			"url":           settingStringAttribute("A custom URL recipients are redirected to after unsubscribing, instead of the landing page"),
+		},
+		read: func(ctx context.Context, client *sendgrid.Client, prior TrackingSettingSubscriptionResourceModel) (TrackingSettingSubscriptionResourceModel, error) {
+			setting, err := client.ReadTrackingSettingSubscription(ctx)
+			if err != nil {
+				return prior, err
+			}
+			return state(setting, prior), nil
+		},
+		update: func(ctx context.Context, client *sendgrid.Client, plan TrackingSettingSubscriptionResourceModel) (TrackingSettingSubscriptionResourceModel, error) {
+			setting, err := client.UpdateTrackingSettingSubscription(ctx, sendgrid.TrackingSettingSubscription{
+				Enabled:      plan.Enabled.ValueBool(),
+				HTMLContent:  plan.HTMLContent.ValueString(),
+				PlainContent: plan.PlainContent.ValueString(),
+				Landing:      plan.Landing.ValueString(),
+				Replace:      plan.Replace.ValueString(),
+				URL:          plan.URL.ValueString(),
+			})
+			if err != nil {
+				return plan, err
+			}
+			return state(setting, plan), nil
+		},
+		disable: func(ctx context.Context, client *sendgrid.Client) error {
+			_, err := client.UpdateTrackingSettingSubscription(ctx, sendgrid.TrackingSettingSubscription{})
+			return err
+		},
+	}
+}
+
+type TrackingSettingGoogleAnalyticsResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	UTMSource   types.String `tfsdk:"utm_source"`
+	UTMMedium   types.String `tfsdk:"utm_medium"`
+	UTMTerm     types.String `tfsdk:"utm_term"`
+	UTMContent  types.String `tfsdk:"utm_content"`
+	UTMCampaign types.String `tfsdk:"utm_campaign"`
+	OnBehalfOf  types.String `tfsdk:"on_behalf_of"`
+}
+
+func NewTrackingSettingGoogleAnalyticsResource() resource.Resource {
+	const name = "tracking_settings_google_analytics"
+
+	state := func(setting *sendgrid.TrackingSettingGoogleAnalytics, prior TrackingSettingGoogleAnalyticsResourceModel) TrackingSettingGoogleAnalyticsResourceModel {
+		return TrackingSettingGoogleAnalyticsResourceModel{
+			ID:          types.StringValue(name),
+			Enabled:     types.BoolValue(setting.Enabled),
+			UTMSource:   types.StringValue(setting.UTMSource),
+			UTMMedium:   types.StringValue(setting.UTMMedium),
+			UTMTerm:     types.StringValue(setting.UTMTerm),
+			UTMContent:  types.StringValue(setting.UTMContent),
+			UTMCampaign: types.StringValue(setting.UTMCampaign),
+			OnBehalfOf:  prior.OnBehalfOf,
+		}
+	}
+
+	return &settingResource[TrackingSettingGoogleAnalyticsResourceModel]{
+		name:        name,
+		description: "Resource to add Google Analytics UTM parameters to the links of emails",
+		attributes: map[string]schema.Attribute{
+			"enabled":      settingEnabledAttribute(),
+			"utm_source":   settingStringAttribute("The utm_source parameter, the referrer of the traffic"),
+			"utm_medium":   settingStringAttribute("The utm_medium parameter, the marketing medium"),
+			"utm_term":     settingStringAttribute("The utm_term parameter, the paid keywords"),
+			"utm_content":  settingStringAttribute("The utm_content parameter, to tell links apart"),
+			"utm_campaign": settingStringAttribute("The utm_campaign parameter, the name of the campaign"),
+		},
+		read: func(ctx context.Context, client *sendgrid.Client, prior TrackingSettingGoogleAnalyticsResourceModel) (TrackingSettingGoogleAnalyticsResourceModel, error) {
+			setting, err := client.ReadTrackingSettingGoogleAnalytics(ctx)
+			if err != nil {
+				return prior, err
+			}
+			return state(setting, prior), nil
+		},
+		update: func(ctx context.Context, client *sendgrid.Client, plan TrackingSettingGoogleAnalyticsResourceModel) (TrackingSettingGoogleAnalyticsResourceModel, error) {
+			setting, err := client.UpdateTrackingSettingGoogleAnalytics(ctx, sendgrid.TrackingSettingGoogleAnalytics{
+				Enabled:     plan.Enabled.ValueBool(),
+				UTMSource:   plan.UTMSource.ValueString(),
+				UTMMedium:   plan.UTMMedium.ValueString(),
+				UTMTerm:     plan.UTMTerm.ValueString(),
+				UTMContent:  plan.UTMContent.ValueString(),
+				UTMCampaign: plan.UTMCampaign.ValueString(),
+			})
+			if err != nil {
+				return plan, err
+			}
+			return state(setting, plan), nil
+		},
+		disable: func(ctx context.Context, client *sendgrid.Client) error {
+			_, err := client.UpdateTrackingSettingGoogleAnalytics(ctx, sendgrid.TrackingSettingGoogleAnalytics{})
+			return err
+		},
+	}
+}
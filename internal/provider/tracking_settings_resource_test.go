@@ -0,0 +1,120 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTrackingSettingClickResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_tracking_settings_click" "test" {
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "id", "tracking_settings_click"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "enable_text", "false"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_tracking_settings_click" "test" {
+					enable_text = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "enable_text", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTrackingSettingOpenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "sendgrid_tracking_settings_open" "test" {
+					enabled = false
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_open.test", "id", "tracking_settings_open"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_open.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTrackingSettingSubscriptionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_tracking_settings_subscription" "test" {
+					html_content  = "<p><a href=\"<% %>\">Unsubscribe</a></p>"
+					plain_content = "Unsubscribe: <% %>"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.test", "plain_content", "Unsubscribe: <% %>"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.test", "url", ""),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_tracking_settings_subscription.test",
+				ImportState:       true,
+				ImportStateId:     "tracking_settings_subscription",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_tracking_settings_subscription" "test" {
+					url = "https://example.com/unsubscribed"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.test", "url", "https://example.com/unsubscribed"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.test", "plain_content", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTrackingSettingGoogleAnalyticsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "sendgrid_tracking_settings_google_analytics" "test" {
+					utm_source   = "sendgrid"
+					utm_medium   = "email"
+					utm_campaign = "newsletter"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_google_analytics.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_google_analytics.test", "utm_medium", "email"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_google_analytics.test", "utm_term", ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &unsubscribeGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &unsubscribeGroupDataSource{}
+)
+
+func NewUnsubscribeGroupDataSource() datasource.DataSource {
+	return &unsubscribeGroupDataSource{}
+}
+
+type unsubscribeGroupDataSource struct {
+	client *sendgrid.Client
+}
+
+type DataUnsubscribeGroupModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	IsDefault    types.Bool   `tfsdk:"is_default"`
+	Unsubscribes types.Int64  `tfsdk:"unsubscribes"`
+}
+
+func (d *unsubscribeGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unsubscribe_group"
+}
+
+func (d *unsubscribeGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *unsubscribeGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an unsubscribe group by name",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the unsubscribe group",
+				Required:    true,
+			},
+			"id": schema.Int64Attribute{
+				Description: "The ID of the unsubscribe group, used as asm.group_id when sending",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the unsubscribe group",
+				Computed:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default group of the account",
+				Computed:    true,
+			},
+			"unsubscribes": schema.Int64Attribute{
+				Description: "The number of recipients unsubscribed from the group",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *unsubscribeGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DataUnsubscribeGroupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := d.client.FindUnsubscribeGroup(ctx, config.Name.ValueString())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unsubscribe group not found",
+				fmt.Sprintf("No unsubscribe group is named %q", config.Name.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error getting unsubscribe group",
+			"Error getting unsubscribe group: "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, DataUnsubscribeGroupModel{
+		ID:           types.Int64Value(group.ID),
+		Name:         types.StringValue(group.Name),
+		Description:  types.StringValue(group.Description),
+		IsDefault:    types.BoolValue(group.IsDefault),
+		Unsubscribes: types.Int64Value(group.Unsubscribes),
+	})
+	resp.Diagnostics.Append(diags...)
+}
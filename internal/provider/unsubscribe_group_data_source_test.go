@@ -0,0 +1,32 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUnsubscribeGroupDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "sendgrid_unsubscribe_group" "test" {
+					name        = "alerts"
+					description = "Account alerts"
+				  }
+
+				data "sendgrid_unsubscribe_group" "test" {
+					name = sendgrid_unsubscribe_group.test.name
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sendgrid_unsubscribe_group.test", "id", "sendgrid_unsubscribe_group.test", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_unsubscribe_group.test", "description", "Account alerts"),
+					resource.TestCheckResourceAttr("data.sendgrid_unsubscribe_group.test", "is_default", "false"),
+				),
+			},
+		},
+	})
+}
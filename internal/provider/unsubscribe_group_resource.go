@@ -0,0 +1,230 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &unsubscribeGroupResource{}
+	_ resource.ResourceWithConfigure   = &unsubscribeGroupResource{}
+	_ resource.ResourceWithImportState = &unsubscribeGroupResource{}
+)
+
+func NewUnsubscribeGroupResource() resource.Resource {
+	return &unsubscribeGroupResource{}
+}
+
+type unsubscribeGroupResource struct {
+	client *sendgrid.Client
+}
+
+type UnsubscribeGroupResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	IsDefault    types.Bool   `tfsdk:"is_default"`
+	Unsubscribes types.Int64  `tfsdk:"unsubscribes"`
+	OnBehalfOf   types.String `tfsdk:"on_behalf_of"`
+}
+
+func (r *unsubscribeGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unsubscribe_group"
+}
+
+func (r *unsubscribeGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage unsubscribe groups, also known as suppression or ASM groups",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the unsubscribe group, used as asm.group_id when sending",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the group, shown to recipients on the manage preferences page. Must be unique within the account",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 30),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the group, shown to recipients on the manage preferences page",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default group of the account. Only one group can be the default",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"unsubscribes": schema.Int64Attribute{
+				Description: "The number of recipients unsubscribed from the group",
+				Computed:    true,
+			},
+			"on_behalf_of": onBehalfOfAttribute(),
+		},
+	}
+}
+
+func unsubscribeGroupState(group *sendgrid.UnsubscribeGroup, onBehalfOf types.String) UnsubscribeGroupResourceModel {
+	return UnsubscribeGroupResourceModel{
+		ID:           types.Int64Value(group.ID),
+		Name:         types.StringValue(group.Name),
+		Description:  types.StringValue(group.Description),
+		IsDefault:    types.BoolValue(group.IsDefault),
+		Unsubscribes: types.Int64Value(group.Unsubscribes),
+		OnBehalfOf:   onBehalfOf,
+	}
+}
+
+func (r *unsubscribeGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UnsubscribeGroupResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := clientFor(r.client, plan.OnBehalfOf).CreateUnsubscribeGroup(ctx, sendgrid.UnsubscribeGroup{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		IsDefault:   plan.IsDefault.ValueBool(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating unsubscribe group", "Could not create unsubscribe group: ", err, "name", "description", "is_default")
+		return
+	}
+
+	tflog.Debug(ctx, "Created unsubscribe group", map[string]any{"id": group.ID})
+
+	diags = resp.State.Set(ctx, unsubscribeGroupState(group, plan.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *unsubscribeGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UnsubscribeGroupResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := clientFor(r.client, state.OnBehalfOf).ReadUnsubscribeGroup(ctx, state.ID.ValueInt64())
+	if err != nil {
+		if sendgrid.IsNotFound(err) {
+			tflog.Warn(ctx, "Unsubscribe group not found, removing from state", map[string]any{"id": state.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading unsubscribe group",
+			fmt.Sprintf("Could not read unsubscribe group %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, unsubscribeGroupState(group, state.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *unsubscribeGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UnsubscribeGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := clientFor(r.client, state.OnBehalfOf).UpdateUnsubscribeGroup(ctx, sendgrid.UnsubscribeGroup{
+		ID:          state.ID.ValueInt64(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		IsDefault:   plan.IsDefault.ValueBool(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating unsubscribe group", "Could not update unsubscribe group: ", err, "name", "description", "is_default")
+		return
+	}
+
+	diags := resp.State.Set(ctx, unsubscribeGroupState(group, state.OnBehalfOf))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *unsubscribeGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UnsubscribeGroupResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := clientFor(r.client, state.OnBehalfOf).DeleteUnsubscribeGroup(ctx, state.ID.ValueInt64())
+	if err != nil && !sendgrid.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting unsubscribe group",
+			fmt.Sprintf("Could not delete unsubscribe group %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted unsubscribe group", map[string]any{"id": state.ID.ValueInt64()})
+}
+
+func (r *unsubscribeGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *unsubscribeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	onBehalfOf, importID := splitOnBehalfOfImportID(req.ID)
+	id, err := strconv.ParseInt(importID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing unsubscribe group",
+			fmt.Sprintf("Unsubscribe group ID must be a number, got %q: %s", importID, err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), onBehalfOf)...)
+}
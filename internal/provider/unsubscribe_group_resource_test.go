@@ -0,0 +1,51 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUnsubscribeGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_unsubscribe_group" "test" {
+					name        = "newsletter"
+					description = "Weekly newsletter"
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group.test", "name", "newsletter"),
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group.test", "description", "Weekly newsletter"),
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group.test", "is_default", "false"),
+					resource.TestCheckResourceAttrSet("sendgrid_unsubscribe_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sendgrid_unsubscribe_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "sendgrid_unsubscribe_group" "test" {
+					name        = "newsletter"
+					description = "Monthly newsletter"
+					is_default  = true
+				  }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group.test", "description", "Monthly newsletter"),
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group.test", "is_default", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
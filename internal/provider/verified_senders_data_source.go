@@ -0,0 +1,207 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	sendgrid "terraform-provider-sendgrid/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &verifiedSendersDataSource{}
+	_ datasource.DataSourceWithConfigure = &verifiedSendersDataSource{}
+)
+
+func NewVerifiedSendersDataSource() datasource.DataSource {
+	return &verifiedSendersDataSource{}
+}
+
+type verifiedSendersDataSource struct {
+	client *sendgrid.Client
+}
+
+type DataVerifiedSendersModel struct {
+	FromEmail          types.String      `tfsdk:"from_email"`
+	Domain             types.String      `tfsdk:"domain"`
+	Senders            []DataSenderModel `tfsdk:"senders"`
+	HardFailureDomains []string          `tfsdk:"hard_failure_domains"`
+	SoftFailureDomains []string          `tfsdk:"soft_failure_domains"`
+}
+
+type DataSenderModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Nickname    types.String `tfsdk:"nickname"`
+	FromEmail   types.String `tfsdk:"from_email"`
+	FromName    types.String `tfsdk:"from_name"`
+	ReplyTo     types.String `tfsdk:"reply_to"`
+	ReplyToName types.String `tfsdk:"reply_to_name"`
+	Address     types.String `tfsdk:"address"`
+	Address2    types.String `tfsdk:"address2"`
+	State       types.String `tfsdk:"state"`
+	City        types.String `tfsdk:"city"`
+	Country     types.String `tfsdk:"country"`
+	Zip         types.String `tfsdk:"zip"`
+	Verified    types.Bool   `tfsdk:"verified"`
+	Locked      types.Bool   `tfsdk:"locked"`
+}
+
+func (d *verifiedSendersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verified_senders"
+}
+
+func (d *verifiedSendersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sendgrid.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *verifiedSendersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	senderAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the senders of the account with their verification state, and the domains SendGrid warns about when creating senders",
+		Attributes: map[string]schema.Attribute{
+			"from_email": schema.StringAttribute{
+				Description: "Only list the senders with this from address, compared case insensitively",
+				Optional:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "Only list the senders with a from address of this domain, compared case insensitively. Senders whose from address has no domain are left out",
+				Optional:    true,
+			},
+			"senders": schema.ListNestedAttribute{
+				Description: "The senders of the account, sorted by ID",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "ID of the sender",
+							Computed:    true,
+						},
+						"nickname":      senderAttribute("Nickname of the sender"),
+						"from_email":    senderAttribute("Email address of the sender"),
+						"from_name":     senderAttribute("Name of the sender"),
+						"reply_to":      senderAttribute("Reply to email address of the sender"),
+						"reply_to_name": senderAttribute("Reply to name of the sender"),
+						"address":       senderAttribute("Address of the sender"),
+						"address2":      senderAttribute("Address2 of the sender"),
+						"state":         senderAttribute("State of the sender"),
+						"city":          senderAttribute("City of the sender"),
+						"country":       senderAttribute("Country of the sender"),
+						"zip":           senderAttribute("Zip of the sender"),
+						"verified": schema.BoolAttribute{
+							Description: "Whether the sender is verified, either through the verification email or because its domain is authenticated",
+							Computed:    true,
+						},
+						"locked": schema.BoolAttribute{
+							Description: "Whether the sender is locked, it cannot be changed or deleted while a draft, scheduled or running campaign uses it",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"hard_failure_domains": schema.ListAttribute{
+				Description: "Domains implementing a DMARC policy that rejects emails sent by SendGrid, senders of these domains cannot be created",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"soft_failure_domains": schema.ListAttribute{
+				Description: "Domains implementing a DMARC policy that may block emails sent by SendGrid, senders of these domains should be avoided",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *verifiedSendersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DataVerifiedSendersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	senders, err := d.client.ListSingleSenders(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing verified senders",
+			"Error listing verified senders: "+err.Error(),
+		)
+		return
+	}
+
+	warnings, err := d.client.ReadSenderDomainWarnList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading sender domain warn list",
+			"Error reading sender domain warn list: "+err.Error(),
+		)
+		return
+	}
+
+	config.Senders = []DataSenderModel{}
+	for _, sender := range senders {
+		if !config.FromEmail.IsNull() && !strings.EqualFold(sender.FromEmail, config.FromEmail.ValueString()) {
+			continue
+		}
+		_, domain, _ := strings.Cut(sender.FromEmail, "@")
+		if !config.Domain.IsNull() && !strings.EqualFold(domain, config.Domain.ValueString()) {
+			continue
+		}
+
+		config.Senders = append(config.Senders, DataSenderModel{
+			ID:          types.Int64Value(sender.ID),
+			Nickname:    types.StringValue(sender.Nickname),
+			FromEmail:   types.StringValue(sender.FromEmail),
+			FromName:    types.StringValue(sender.FromName),
+			ReplyTo:     types.StringValue(sender.ReplyTo),
+			ReplyToName: types.StringValue(sender.ReplyToName),
+			Address:     types.StringValue(sender.Address),
+			Address2:    types.StringValue(sender.Address2),
+			State:       types.StringValue(sender.State),
+			City:        types.StringValue(sender.City),
+			Country:     types.StringValue(sender.Country),
+			Zip:         types.StringValue(sender.Zip),
+			Verified:    types.BoolValue(sender.Verified),
+			Locked:      types.BoolValue(sender.Locked),
+		})
+	}
+
+	sort.Slice(config.Senders, func(i, j int) bool {
+		return config.Senders[i].ID.ValueInt64() < config.Senders[j].ID.ValueInt64()
+	})
+
+	config.HardFailureDomains, config.SoftFailureDomains = warnings.HardFailures, warnings.SoftFailures
+	if config.HardFailureDomains == nil {
+		config.HardFailureDomains = []string{}
+	}
+	if config.SoftFailureDomains == nil {
+		config.SoftFailureDomains = []string{}
+	}
+
+	diags := resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
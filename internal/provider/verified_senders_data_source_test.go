@@ -0,0 +1,48 @@
+package sendgrid
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVerifiedSendersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "sendgrid_single_sender" "test" {
+	nickname = "senders"
+	from_email = "senders@example.org"
+	from_name = "Senders"
+	reply_to = "senders@example.org"
+	reply_to_name = "Senders"
+	address = "1234 Fake St"
+	address2 = ""
+	city = "San Francisco"
+	state = "CA"
+	zip = "95369"
+	country = "US"
+}
+
+data "sendgrid_verified_senders" "by_email" {
+	from_email = sendgrid_single_sender.test.from_email
+}
+
+data "sendgrid_verified_senders" "by_domain" {
+	domain     = "EXAMPLE.ORG"
+	depends_on = [sendgrid_single_sender.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_verified_senders.by_email", "senders.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sendgrid_verified_senders.by_email", "senders.0.id", "sendgrid_single_sender.test", "id"),
+					resource.TestCheckResourceAttr("data.sendgrid_verified_senders.by_email", "senders.0.verified", "false"),
+					resource.TestCheckResourceAttr("data.sendgrid_verified_senders.by_domain", "senders.#", "1"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_verified_senders.by_domain", "hard_failure_domains.#"),
+				),
+			},
+		},
+	})
+}
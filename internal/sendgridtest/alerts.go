@@ -0,0 +1,148 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"sort"
+	"time"
+)
+
+type alert struct {
+	ID         int64  `json:"id"`
+	Type       string `json:"type"`
+	EmailTo    string `json:"email_to"`
+	Frequency  string `json:"frequency,omitempty"`
+	Percentage int64  `json:"percentage,omitempty"`
+	CreatedAt  int64  `json:"created_at"`
+	UpdatedAt  int64  `json:"updated_at"`
+}
+
+func (s *Server) registerAlerts() {
+	s.handle("POST", "/alerts", s.createAlert)
+	s.handle("GET", "/alerts", s.listAlerts)
+	s.handle("GET", "/alerts/{id}", s.getAlert)
+	s.handle("PATCH", "/alerts/{id}", s.updateAlert)
+	s.handle("DELETE", "/alerts/{id}", s.deleteAlert)
+}
+
+func (s *Server) createAlert(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body alert
+	if !decode(w, r, &body) {
+		return
+	}
+	if !validAlert(w, &body) {
+		return
+	}
+
+	now := time.Now().Unix()
+	a := body
+	a.ID = s.newID()
+	a.CreatedAt = now
+	a.UpdatedAt = now
+	s.alerts[a.ID] = &a
+
+	writeJSON(w, http.StatusCreated, a)
+}
+
+func (s *Server) listAlerts(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	alerts := make([]*alert, 0, len(s.alerts))
+	for _, a := range s.alerts {
+		alerts = append(alerts, a)
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].ID < alerts[j].ID })
+
+	writeJSON(w, http.StatusOK, alerts)
+}
+
+func (s *Server) getAlert(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	a, ok := s.lookupAlert(w, params["id"])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+// updateAlert changes the recipient and the threshold or frequency, the
+// type of an alert cannot be changed.
+func (s *Server) updateAlert(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	a, ok := s.lookupAlert(w, params["id"])
+	if !ok {
+		return
+	}
+
+	body := *a
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Type != a.Type {
+		writeError(w, http.StatusBadRequest, "type", "type cannot be changed")
+		return
+	}
+	if !validAlert(w, &body) {
+		return
+	}
+
+	a.EmailTo = body.EmailTo
+	a.Frequency = body.Frequency
+	a.Percentage = body.Percentage
+	a.UpdatedAt = time.Now().Unix()
+
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) deleteAlert(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	a, ok := s.lookupAlert(w, params["id"])
+	if !ok {
+		return
+	}
+	delete(s.alerts, a.ID)
+	writeNoContent(w)
+}
+
+// validAlert checks the fields required by the type of the alert: usage
+// limit alerts fire at a percentage of the plan, stats notifications are
+// sent at a frequency.
+func validAlert(w http.ResponseWriter, a *alert) bool {
+	if a.EmailTo == "" {
+		writeError(w, http.StatusBadRequest, "email_to", "missing required argument")
+		return false
+	}
+
+	switch a.Type {
+	case "usage_limit":
+		if a.Percentage < 1 || a.Percentage > 100 {
+			writeError(w, http.StatusBadRequest, "percentage", "percentage must be between 1 and 100")
+			return false
+		}
+		if a.Frequency != "" {
+			writeError(w, http.StatusBadRequest, "frequency", "frequency is not allowed for usage_limit alerts")
+			return false
+		}
+	case "stats_notification":
+		if a.Frequency != "daily" && a.Frequency != "weekly" && a.Frequency != "monthly" {
+			writeError(w, http.StatusBadRequest, "frequency", "frequency must be daily, weekly or monthly")
+			return false
+		}
+		if a.Percentage != 0 {
+			writeError(w, http.StatusBadRequest, "percentage", "percentage is not allowed for stats_notification alerts")
+			return false
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "type", "type must be usage_limit or stats_notification")
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) lookupAlert(w http.ResponseWriter, value string) (*alert, bool) {
+	id, ok := parseID(w, value)
+	if !ok {
+		return nil, false
+	}
+	a, ok := s.alerts[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return a, true
+}
@@ -0,0 +1,113 @@
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+type apiKey struct {
+	seq    int64
+	ID     string   `json:"api_key_id"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+func (s *Server) registerAPIKeys() {
+	s.handle("POST", "/api_keys", s.createAPIKey)
+	s.handle("GET", "/api_keys", s.listAPIKeys)
+	s.handle("GET", "/api_keys/{id}", s.getAPIKey)
+	s.handle("PUT", "/api_keys/{id}", s.updateAPIKey)
+	s.handle("PATCH", "/api_keys/{id}", s.updateAPIKey)
+	s.handle("DELETE", "/api_keys/{id}", s.deleteAPIKey)
+}
+
+func (s *Server) createAPIKey(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body apiKey
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name", "missing required argument")
+		return
+	}
+	if len(body.Scopes) == 0 {
+		body.Scopes = []string{"mail.send"}
+	}
+
+	seq := s.newID()
+	key := &apiKey{
+		seq:    seq,
+		ID:     fmt.Sprintf("fake-key-%d", seq),
+		Name:   body.Name,
+		Scopes: body.Scopes,
+	}
+	s.apiKeys[key.ID] = key
+
+	writeJSON(w, http.StatusCreated, struct {
+		APIKey string   `json:"api_key"`
+		ID     string   `json:"api_key_id"`
+		Name   string   `json:"name"`
+		Scopes []string `json:"scopes"`
+	}{
+		APIKey: fmt.Sprintf("SG.%s.secret", key.ID),
+		ID:     key.ID,
+		Name:   key.Name,
+		Scopes: key.Scopes,
+	})
+}
+
+func (s *Server) listAPIKeys(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	keys := make([]*apiKey, 0, len(s.apiKeys))
+	for _, key := range s.apiKeys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].seq < keys[j].seq })
+
+	start, end := page(w, r, len(keys))
+	writeJSON(w, http.StatusOK, map[string][]*apiKey{"result": keys[start:end]})
+}
+
+func (s *Server) getAPIKey(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	key, ok := s.apiKeys[params["id"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, key)
+}
+
+// updateAPIKey serves both PUT, which replaces name and scopes, and PATCH,
+// which only renames the key.
+func (s *Server) updateAPIKey(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	key, ok := s.apiKeys[params["id"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	var body apiKey
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name", "missing required argument")
+		return
+	}
+
+	key.Name = body.Name
+	if r.Method == "PUT" && len(body.Scopes) > 0 {
+		key.Scopes = body.Scopes
+	}
+
+	writeJSON(w, http.StatusOK, key)
+}
+
+func (s *Server) deleteAPIKey(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.apiKeys[params["id"]]; !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.apiKeys, params["id"])
+	writeNoContent(w)
+}
@@ -0,0 +1,266 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type recipientEmails struct {
+	RecipientEmails []string `json:"recipient_emails"`
+}
+
+type unsubscribeGroup struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	IsDefault    bool   `json:"is_default"`
+	Unsubscribes int64  `json:"unsubscribes"`
+
+	suppressions map[string]bool
+}
+
+func (s *Server) registerASM() {
+	s.handle("POST", "/asm/groups", s.createGroup)
+	s.handle("GET", "/asm/groups", s.listGroups)
+	s.handle("GET", "/asm/groups/{id}", s.getGroup)
+	s.handle("PATCH", "/asm/groups/{id}", s.updateGroup)
+	s.handle("DELETE", "/asm/groups/{id}", s.deleteGroup)
+	s.handle("POST", "/asm/groups/{id}/suppressions", s.addGroupSuppressions)
+	s.handle("GET", "/asm/groups/{id}/suppressions", s.listGroupSuppressions)
+	s.handle("POST", "/asm/groups/{id}/suppressions/search", s.searchGroupSuppressions)
+	s.handle("DELETE", "/asm/groups/{id}/suppressions/{email}", s.deleteGroupSuppression)
+	s.handle("POST", "/asm/suppressions/global", s.addGlobalSuppressions)
+	s.handle("GET", "/asm/suppressions/global/{email}", s.getGlobalSuppression)
+	s.handle("DELETE", "/asm/suppressions/global/{email}", s.deleteGlobalSuppression)
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body unsubscribeGroup
+	if !decode(w, r, &body) {
+		return
+	}
+	if !s.validGroup(w, &body, 0) {
+		return
+	}
+
+	g := &unsubscribeGroup{
+		ID:           s.newID(),
+		Name:         body.Name,
+		Description:  body.Description,
+		suppressions: map[string]bool{},
+	}
+	s.groups[g.ID] = g
+	if body.IsDefault {
+		s.setDefaultGroup(g)
+	}
+
+	writeJSON(w, http.StatusCreated, g)
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	groups := make([]*unsubscribeGroup, 0, len(s.groups))
+	for _, g := range s.groups {
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].ID < groups[j].ID })
+
+	writeJSON(w, http.StatusOK, groups)
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	g, ok := s.lookupGroup(w, params["id"])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, g)
+}
+
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	g, ok := s.lookupGroup(w, params["id"])
+	if !ok {
+		return
+	}
+
+	body := *g
+	if !decode(w, r, &body) {
+		return
+	}
+	if !s.validGroup(w, &body, g.ID) {
+		return
+	}
+
+	g.Name = body.Name
+	g.Description = body.Description
+	if body.IsDefault {
+		s.setDefaultGroup(g)
+	} else {
+		g.IsDefault = false
+	}
+
+	writeJSON(w, http.StatusOK, g)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	g, ok := s.lookupGroup(w, params["id"])
+	if !ok {
+		return
+	}
+	delete(s.groups, g.ID)
+	writeNoContent(w)
+}
+
+// validGroup checks the limits SendGrid enforces on groups. Names are unique
+// within an account.
+func (s *Server) validGroup(w http.ResponseWriter, g *unsubscribeGroup, id int64) bool {
+	switch {
+	case g.Name == "":
+		writeError(w, http.StatusBadRequest, "name", "missing required argument")
+		return false
+	case len(g.Name) > 30:
+		writeError(w, http.StatusBadRequest, "name", "name must be 30 characters or less")
+		return false
+	case len(g.Description) > 100:
+		writeError(w, http.StatusBadRequest, "description", "description must be 100 characters or less")
+		return false
+	}
+
+	for _, existing := range s.groups {
+		if existing.ID != id && existing.Name == g.Name {
+			writeError(w, http.StatusBadRequest, "name", "name already exists")
+			return false
+		}
+	}
+
+	return true
+}
+
+// setDefaultGroup makes g the only default group of the account.
+func (s *Server) setDefaultGroup(g *unsubscribeGroup) {
+	for _, existing := range s.groups {
+		existing.IsDefault = false
+	}
+	g.IsDefault = true
+}
+
+func (s *Server) lookupGroup(w http.ResponseWriter, value string) (*unsubscribeGroup, bool) {
+	id, ok := parseID(w, value)
+	if !ok {
+		return nil, false
+	}
+	g, ok := s.groups[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return g, true
+}
+
+func (s *Server) addGroupSuppressions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	g, ok := s.lookupGroup(w, params["id"])
+	if !ok {
+		return
+	}
+
+	var body recipientEmails
+	if !decode(w, r, &body) || !validRecipientEmails(w, body) {
+		return
+	}
+
+	for _, email := range body.RecipientEmails {
+		g.suppressions[email] = true
+	}
+	g.Unsubscribes = int64(len(g.suppressions))
+
+	writeJSON(w, http.StatusCreated, body)
+}
+
+func (s *Server) listGroupSuppressions(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	g, ok := s.lookupGroup(w, params["id"])
+	if !ok {
+		return
+	}
+
+	emails := make([]string, 0, len(g.suppressions))
+	for email := range g.suppressions {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	writeJSON(w, http.StatusOK, emails)
+}
+
+func (s *Server) searchGroupSuppressions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	g, ok := s.lookupGroup(w, params["id"])
+	if !ok {
+		return
+	}
+
+	var body recipientEmails
+	if !decode(w, r, &body) || !validRecipientEmails(w, body) {
+		return
+	}
+
+	found := []string{}
+	for _, email := range body.RecipientEmails {
+		if g.suppressions[email] {
+			found = append(found, email)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, found)
+}
+
+func (s *Server) deleteGroupSuppression(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	g, ok := s.lookupGroup(w, params["id"])
+	if !ok {
+		return
+	}
+
+	delete(g.suppressions, params["email"])
+	g.Unsubscribes = int64(len(g.suppressions))
+
+	writeNoContent(w)
+}
+
+func (s *Server) addGlobalSuppressions(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body recipientEmails
+	if !decode(w, r, &body) || !validRecipientEmails(w, body) {
+		return
+	}
+
+	for _, email := range body.RecipientEmails {
+		s.suppressions[email] = true
+	}
+
+	writeJSON(w, http.StatusCreated, body)
+}
+
+// getGlobalSuppression answers with an empty object for emails that are not
+// suppressed, like SendGrid does.
+func (s *Server) getGlobalSuppression(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if !s.suppressions[params["email"]] {
+		writeJSON(w, http.StatusOK, struct{}{})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"recipient_email": params["email"]})
+}
+
+func (s *Server) deleteGlobalSuppression(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	delete(s.suppressions, params["email"])
+	writeNoContent(w)
+}
+
+func validRecipientEmails(w http.ResponseWriter, body recipientEmails) bool {
+	if len(body.RecipientEmails) == 0 {
+		writeError(w, http.StatusBadRequest, "recipient_emails", "missing required argument")
+		return false
+	}
+	for _, email := range body.RecipientEmails {
+		if !strings.Contains(email, "@") {
+			writeError(w, http.StatusBadRequest, "recipient_emails", "invalid email "+email)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,413 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"sort"
+	"time"
+)
+
+// DedicatedIPs are the IPs owned by the fake account. Dedicated IPs are
+// bought rather than created through the API, so the fake starts with them.
+// All but the last one are assigned to the account. Like the API, the fake
+// does not list them in address order, 192.0.2.10 comes before 192.0.2.2.
+var DedicatedIPs = []string{"192.0.2.1", "192.0.2.2", "192.0.2.3", "192.0.2.10", "192.0.2.4"}
+
+type dedicatedIP struct {
+	IP           string
+	Pools        []string
+	Warmup       bool
+	StartDate    *int64
+	Rdns         string
+	Whitelabeled bool
+	AssignedAt   *int64
+}
+
+// ipResult is an IP as listed by GET /ips, including the subusers it is
+// assigned to.
+type ipResult struct {
+	IP           string   `json:"ip"`
+	Subusers     []string `json:"subusers"`
+	Rdns         string   `json:"rdns,omitempty"`
+	Pools        []string `json:"pools"`
+	Warmup       bool     `json:"warmup"`
+	StartDate    *int64   `json:"start_date"`
+	Whitelabeled bool     `json:"whitelabeled"`
+	AssignedAt   *int64   `json:"assigned_at"`
+}
+
+// poolIP is an IP as listed in a pool.
+type poolIP struct {
+	IP        string `json:"ip"`
+	StartDate *int64 `json:"start_date"`
+	Warmup    bool   `json:"warmup"`
+}
+
+func (s *Server) seedIPs() {
+	assignedAt := time.Now().Unix()
+	for i, ip := range DedicatedIPs {
+		s.ips[ip] = &dedicatedIP{IP: ip, Pools: []string{}}
+		if i < len(DedicatedIPs)-1 {
+			s.ips[ip].AssignedAt = &assignedAt
+		}
+	}
+}
+
+// FinishIPWarmup ends the warmup of ip, as SendGrid does once the IP is
+// fully warmed up. It reports whether the IP was in warmup.
+func (s *Server) FinishIPWarmup(ip string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dedicated, ok := s.ips[ip]
+	if !ok || !dedicated.Warmup {
+		return false
+	}
+	dedicated.Warmup = false
+	dedicated.StartDate = nil
+	return true
+}
+
+func (s *Server) registerIPs() {
+	s.handleParent("GET", "/ips", s.listIPs)
+	s.handleParent("GET", "/ips/assigned", s.listAssignedIPs)
+	s.handleParent("POST", "/ips/pools", s.createIPPool)
+	s.handleParent("GET", "/ips/pools", s.listIPPools)
+	s.handleParent("GET", "/ips/pools/{name}", s.getIPPool)
+	s.handleParent("PUT", "/ips/pools/{name}", s.renameIPPool)
+	s.handleParent("DELETE", "/ips/pools/{name}", s.deleteIPPool)
+	s.handleParent("POST", "/ips/pools/{name}/ips", s.addIPToPool)
+	s.handleParent("DELETE", "/ips/pools/{name}/ips/{ip}", s.removeIPFromPool)
+	s.handleParent("POST", "/ips/warmup", s.startIPWarmup)
+	s.handleParent("GET", "/ips/warmup", s.listIPWarmup)
+	s.handleParent("GET", "/ips/warmup/{ip}", s.getIPWarmup)
+	s.handleParent("DELETE", "/ips/warmup/{ip}", s.stopIPWarmup)
+	s.handleParent("GET", "/ips/{ip}", s.getIP)
+}
+
+func (s *Server) sortedIPs() []*dedicatedIP {
+	ips := make([]*dedicatedIP, 0, len(s.ips))
+	for _, ip := range s.ips {
+		ips = append(ips, ip)
+	}
+	sort.Slice(ips, func(i, j int) bool { return ips[i].IP < ips[j].IP })
+	return ips
+}
+
+func (s *Server) ipResult(ip *dedicatedIP) ipResult {
+	subusers := []string{}
+	for _, user := range s.subusers {
+		for _, assigned := range user.Ips {
+			if assigned == ip.IP {
+				subusers = append(subusers, user.Username)
+			}
+		}
+	}
+	sort.Strings(subusers)
+
+	return ipResult{
+		IP:           ip.IP,
+		Subusers:     subusers,
+		Rdns:         ip.Rdns,
+		Pools:        ip.Pools,
+		Warmup:       ip.Warmup,
+		StartDate:    ip.StartDate,
+		Whitelabeled: ip.Whitelabeled,
+		AssignedAt:   ip.AssignedAt,
+	}
+}
+
+// listIPs lists every IP of the account, or the IPs of one subuser when the
+// subuser query parameter is set.
+func (s *Server) listIPs(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ips := s.sortedIPs()
+	if name := r.URL.Query().Get("subuser"); name != "" {
+		var owned []*dedicatedIP
+		if user, ok := s.subusers[name]; ok {
+			for _, ip := range ips {
+				if containsString(user.Ips, ip.IP) {
+					owned = append(owned, ip)
+				}
+			}
+		}
+		ips = owned
+	}
+
+	start, end := page(w, r, len(ips))
+	results := make([]ipResult, 0, end-start)
+	for _, ip := range ips[start:end] {
+		results = append(results, s.ipResult(ip))
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+func (s *Server) getIP(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	ip, ok := s.ips[params["ip"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.ipResult(ip))
+}
+
+func (s *Server) listAssignedIPs(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	type assignedIP struct {
+		IP        string   `json:"ip"`
+		Pools     []string `json:"pools"`
+		Warmup    bool     `json:"warmup"`
+		StartDate *int64   `json:"start_date"`
+	}
+
+	results := []assignedIP{}
+	for _, ip := range s.sortedIPs() {
+		if ip.AssignedAt == nil {
+			continue
+		}
+		results = append(results, assignedIP{IP: ip.IP, Pools: ip.Pools, Warmup: ip.Warmup, StartDate: ip.StartDate})
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+func (s *Server) createIPPool(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body struct {
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	switch {
+	case body.Name == "":
+		writeError(w, http.StatusBadRequest, "name", "missing required argument")
+		return
+	case len(body.Name) > 64:
+		writeError(w, http.StatusBadRequest, "name", "name must be at most 64 characters")
+		return
+	case s.pools[body.Name]:
+		writeError(w, http.StatusBadRequest, "name", "pool name already exists")
+		return
+	}
+	s.pools[body.Name] = true
+
+	writeJSON(w, http.StatusOK, map[string]string{"name": body.Name})
+}
+
+func (s *Server) listIPPools(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	names := make([]string, 0, len(s.pools))
+	for name := range s.pools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pools := make([]map[string]string, 0, len(names))
+	for _, name := range names {
+		pools = append(pools, map[string]string{"name": name})
+	}
+	writeJSON(w, http.StatusOK, pools)
+}
+
+func (s *Server) getIPPool(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	name := params["name"]
+	if !s.pools[name] {
+		writeNotFound(w)
+		return
+	}
+
+	ips := []poolIP{}
+	for _, ip := range s.sortedIPs() {
+		if containsString(ip.Pools, name) {
+			ips = append(ips, poolIP{IP: ip.IP, StartDate: ip.StartDate, Warmup: ip.Warmup})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, struct {
+		PoolName string   `json:"pool_name"`
+		Ips      []poolIP `json:"ips"`
+	}{name, ips})
+}
+
+func (s *Server) renameIPPool(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	name := params["name"]
+	if !s.pools[name] {
+		writeNotFound(w)
+		return
+	}
+
+	var body struct {
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	switch {
+	case body.Name == "":
+		writeError(w, http.StatusBadRequest, "name", "missing required argument")
+		return
+	case len(body.Name) > 64:
+		writeError(w, http.StatusBadRequest, "name", "name must be at most 64 characters")
+		return
+	case body.Name != name && s.pools[body.Name]:
+		writeError(w, http.StatusBadRequest, "name", "pool name already exists")
+		return
+	}
+
+	delete(s.pools, name)
+	s.pools[body.Name] = true
+	for _, ip := range s.ips {
+		for i, pool := range ip.Pools {
+			if pool == name {
+				ip.Pools[i] = body.Name
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"name": body.Name})
+}
+
+func (s *Server) deleteIPPool(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	name := params["name"]
+	if !s.pools[name] {
+		writeNotFound(w)
+		return
+	}
+
+	delete(s.pools, name)
+	for _, ip := range s.ips {
+		ip.Pools = removeString(ip.Pools, name)
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) addIPToPool(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	name := params["name"]
+	if !s.pools[name] {
+		writeNotFound(w)
+		return
+	}
+
+	var body struct {
+		IP string `json:"ip"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	ip, ok := s.ips[body.IP]
+	switch {
+	case body.IP == "":
+		writeError(w, http.StatusBadRequest, "ip", "missing required argument")
+		return
+	case !ok:
+		writeError(w, http.StatusBadRequest, "ip", "ip address not found on the account")
+		return
+	case containsString(ip.Pools, name):
+		writeError(w, http.StatusBadRequest, "ip", "ip address already in pool")
+		return
+	}
+	ip.Pools = append(ip.Pools, name)
+
+	writeJSON(w, http.StatusCreated, struct {
+		IP        string   `json:"ip"`
+		Pools     []string `json:"pools"`
+		StartDate *int64   `json:"start_date"`
+		Warmup    bool     `json:"warmup"`
+	}{ip.IP, ip.Pools, ip.StartDate, ip.Warmup})
+}
+
+func (s *Server) removeIPFromPool(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	ip, ok := s.ips[params["ip"]]
+	if !s.pools[params["name"]] || !ok || !containsString(ip.Pools, params["name"]) {
+		writeNotFound(w)
+		return
+	}
+
+	ip.Pools = removeString(ip.Pools, params["name"])
+
+	writeNoContent(w)
+}
+
+// ipWarmup is an IP in warmup. Warmup endpoints always answer with a list.
+type ipWarmup struct {
+	IP        string `json:"ip"`
+	StartDate int64  `json:"start_date"`
+}
+
+func (s *Server) startIPWarmup(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body struct {
+		IP string `json:"ip"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	ip, ok := s.ips[body.IP]
+	switch {
+	case body.IP == "":
+		writeError(w, http.StatusBadRequest, "ip", "missing required argument")
+		return
+	case !ok:
+		writeNotFound(w)
+		return
+	case ip.Warmup:
+		writeError(w, http.StatusBadRequest, "ip", "ip address is already in warmup")
+		return
+	}
+
+	startDate := time.Now().Unix()
+	ip.Warmup = true
+	ip.StartDate = &startDate
+
+	writeJSON(w, http.StatusOK, []ipWarmup{{IP: ip.IP, StartDate: startDate}})
+}
+
+func (s *Server) listIPWarmup(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	warmups := []ipWarmup{}
+	for _, ip := range s.sortedIPs() {
+		if ip.Warmup {
+			warmups = append(warmups, ipWarmup{IP: ip.IP, StartDate: *ip.StartDate})
+		}
+	}
+	writeJSON(w, http.StatusOK, warmups)
+}
+
+func (s *Server) getIPWarmup(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	ip, ok := s.ips[params["ip"]]
+	if !ok || !ip.Warmup {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, []ipWarmup{{IP: ip.IP, StartDate: *ip.StartDate}})
+}
+
+func (s *Server) stopIPWarmup(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	ip, ok := s.ips[params["ip"]]
+	if !ok || !ip.Warmup {
+		writeNotFound(w)
+		return
+	}
+
+	ip.Warmup = false
+	ip.StartDate = nil
+
+	writeNoContent(w)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(values []string, value string) []string {
+	kept := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != value {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
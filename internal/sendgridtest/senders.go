@@ -0,0 +1,184 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+type sender struct {
+	ID          int64  `json:"id"`
+	Nickname    string `json:"nickname"`
+	FromEmail   string `json:"from_email"`
+	FromName    string `json:"from_name"`
+	ReplyTo     string `json:"reply_to"`
+	ReplyToName string `json:"reply_to_name"`
+	Address     string `json:"address"`
+	Address2    string `json:"address2"`
+	State       string `json:"state"`
+	City        string `json:"city"`
+	Country     string `json:"country"`
+	Zip         string `json:"zip"`
+	Verified    bool   `json:"verified"`
+	Locked      bool   `json:"locked"`
+}
+
+func (s *Server) registerSenders() {
+	s.handle("POST", "/verified_senders", s.createSender)
+	s.handle("GET", "/verified_senders", s.listSenders)
+	s.handle("GET", "/verified_senders/domains", s.listSenderDomainWarnings)
+	s.handle("PATCH", "/verified_senders/{id}", s.updateSender)
+	s.handle("DELETE", "/verified_senders/{id}", s.deleteSender)
+	s.handle("POST", "/verified_senders/resend/{id}", s.resendSenderVerification)
+}
+
+// VerifySender marks the sender with the given from address as verified, as
+// if the confirmation mail had been clicked.
+func (s *Server) VerifySender(fromEmail string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sender := range s.senders {
+		if sender.FromEmail == fromEmail {
+			sender.Verified = true
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) createSender(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body sender
+	if !decode(w, r, &body) {
+		return
+	}
+	if !validSender(w, &body) {
+		return
+	}
+	for _, existing := range s.senders {
+		if existing.Nickname == body.Nickname {
+			writeError(w, http.StatusBadRequest, "nickname", "already exists")
+			return
+		}
+	}
+
+	body.ID = s.newID()
+	body.Verified = false
+	body.Locked = false
+	s.senders[body.ID] = &body
+
+	writeJSON(w, http.StatusCreated, body)
+}
+
+func (s *Server) listSenders(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	senders := make([]*sender, 0, len(s.senders))
+	for _, sender := range s.senders {
+		senders = append(senders, sender)
+	}
+	sort.Slice(senders, func(i, j int) bool { return senders[i].ID < senders[j].ID })
+
+	// verified senders are paged by the id of the last sender seen, not by
+	// offset
+	if lastSeen, err := strconv.ParseInt(r.URL.Query().Get("lastSeenID"), 10, 64); err == nil {
+		for len(senders) > 0 && senders[0].ID <= lastSeen {
+			senders = senders[1:]
+		}
+	}
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit > 0 && limit < len(senders) {
+		senders = senders[:limit]
+	}
+
+	writeJSON(w, http.StatusOK, map[string][]*sender{"results": senders})
+}
+
+// SenderHardFailureDomains and SenderSoftFailureDomains are the DMARC domain
+// warn list of the fake, an excerpt of the real one.
+var (
+	SenderHardFailureDomains = []string{"aol.com", "yahoo.com"}
+	SenderSoftFailureDomains = []string{"gmail.com"}
+)
+
+func (s *Server) listSenderDomainWarnings(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, map[string]map[string][]string{"results": {
+		"hard_failures": SenderHardFailureDomains,
+		"soft_failures": SenderSoftFailureDomains,
+	}})
+}
+
+// updateSender only changes the fields present in the request body.
+func (s *Server) updateSender(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	existing, ok := s.lookupSender(w, params["id"])
+	if !ok {
+		return
+	}
+
+	updated := *existing
+	if !decode(w, r, &updated) {
+		return
+	}
+	if !validSender(w, &updated) {
+		return
+	}
+
+	updated.ID = existing.ID
+	updated.Verified = existing.Verified && updated.FromEmail == existing.FromEmail
+	updated.Locked = existing.Locked
+	*existing = updated
+
+	writeJSON(w, http.StatusOK, existing)
+}
+
+func (s *Server) deleteSender(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	existing, ok := s.lookupSender(w, params["id"])
+	if !ok {
+		return
+	}
+	delete(s.senders, existing.ID)
+	writeNoContent(w)
+}
+
+func (s *Server) resendSenderVerification(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	existing, ok := s.lookupSender(w, params["id"])
+	if !ok {
+		return
+	}
+	if existing.Verified {
+		writeError(w, http.StatusBadRequest, "", "sender is already verified")
+		return
+	}
+	writeNoContent(w)
+}
+
+func (s *Server) lookupSender(w http.ResponseWriter, value string) (*sender, bool) {
+	id, ok := parseID(w, value)
+	if !ok {
+		return nil, false
+	}
+	existing, ok := s.senders[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return existing, true
+}
+
+func validSender(w http.ResponseWriter, body *sender) bool {
+	required := []struct {
+		field string
+		value string
+	}{
+		{"nickname", body.Nickname},
+		{"from_email", body.FromEmail},
+		{"reply_to", body.ReplyTo},
+		{"address", body.Address},
+		{"city", body.City},
+		{"country", body.Country},
+	}
+	for _, r := range required {
+		if r.value == "" {
+			writeError(w, http.StatusBadRequest, r.field, "missing required argument")
+			return false
+		}
+	}
+	return true
+}
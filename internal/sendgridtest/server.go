@@ -0,0 +1,297 @@
+// Package sendgridtest provides an in-memory stand-in for the SendGrid v3 API.
+//
+// The fake keeps state between requests so that the client and the provider
+// resources can be exercised end to end without network access:
+//
+//	srv := sendgridtest.NewServer()
+//	defer srv.Close()
+//
+//	client, _ := sendgrid.NewClient(sendgridtest.APIKey, sendgrid.WithBaseURL(srv.BaseURL()))
+package sendgridtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// APIKey is a key accepted by the fake. Any non-empty bearer token is
+// accepted, this one merely looks like a real key.
+const APIKey = "SG.sendgridtest.0000000000000000000000000000000000000000000"
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params map[string]string)
+
+type route struct {
+	method     string
+	segments   []string
+	handler    handlerFunc
+	parentOnly bool
+}
+
+// Server is a stateful fake of the SendGrid v3 API served by httptest.
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	routes []route
+	nextID int64
+
+	apiKeys      map[string]*apiKey
+	subusers     map[string]*subuser
+	teammates    map[string]*teammate
+	pending      map[string]*teammate
+	domains      map[int64]*domain
+	links        map[int64]*link
+	reverseDNS   map[int64]*reverseDNS
+	senders      map[int64]*sender
+	whitelist    map[int64]*whitelistedIP
+	templates    map[string]*template
+	groups       map[int64]*unsubscribeGroup
+	suppressions map[string]bool
+	parses       map[string]*inboundParse
+	settings     map[string]map[string]interface{}
+	ips          map[string]*dedicatedIP
+	pools        map[string]bool
+	alerts       map[int64]*alert
+
+	eventWebhook    eventWebhook
+	eventWebhookKey string
+
+	onBehalfOf []string
+}
+
+// NewServer starts a fake with empty state. Callers must Close it.
+func NewServer() *Server {
+	s := &Server{
+		nextID:       1,
+		apiKeys:      map[string]*apiKey{},
+		subusers:     map[string]*subuser{},
+		teammates:    map[string]*teammate{},
+		pending:      map[string]*teammate{},
+		domains:      map[int64]*domain{},
+		links:        map[int64]*link{},
+		reverseDNS:   map[int64]*reverseDNS{},
+		senders:      map[int64]*sender{},
+		whitelist:    map[int64]*whitelistedIP{},
+		templates:    map[string]*template{},
+		groups:       map[int64]*unsubscribeGroup{},
+		suppressions: map[string]bool{},
+		parses:       map[string]*inboundParse{},
+		settings:     map[string]map[string]interface{}{},
+		ips:          map[string]*dedicatedIP{},
+		pools:        map[string]bool{},
+		alerts:       map[int64]*alert{},
+	}
+	s.seedIPs()
+
+	s.registerAPIKeys()
+	s.registerSubusers()
+	s.registerTeammates()
+	s.registerDomains()
+	s.registerLinks()
+	s.registerReverseDNS()
+	s.registerSenders()
+	s.registerWhitelist()
+	s.registerTemplates()
+	s.registerASM()
+	s.registerWebhooks()
+	s.registerMailSettings()
+	s.registerTrackingSettings()
+	s.registerIPs()
+	s.registerAlerts()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// BaseURL returns the URL to configure as the client base URL.
+func (s *Server) BaseURL() string {
+	return s.URL + "/v3"
+}
+
+// handle registers a handler for a method and a path below /v3. Path
+// segments written as {name} match any value and are passed to the handler.
+// Routes are matched in registration order.
+func (s *Server) handle(method, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+// handleParent registers a route that only the parent account may call.
+// Requests impersonating a subuser through the on-behalf-of header are
+// rejected, as SendGrid does for subuser, teammate and IP management.
+func (s *Server) handleParent(method, pattern string, handler handlerFunc) {
+	s.handle(method, pattern, handler)
+	s.routes[len(s.routes)-1].parentOnly = true
+}
+
+// OnBehalfOf returns the requests sent on behalf of a subuser so far, as
+// "<subuser> <method> <path>", in the order they were served.
+func (s *Server) OnBehalfOf() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.onBehalfOf...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") ||
+		strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ") == "" {
+		writeError(w, http.StatusUnauthorized, "", "authorization required")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v3")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	pathMatched := false
+	for _, rt := range s.routes {
+		params, ok := match(rt.segments, segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if rt.method != r.Method {
+			continue
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if !s.checkOnBehalfOf(w, r, rt) {
+			return
+		}
+		rt.handler(w, r, params)
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "", "resource not found")
+}
+
+// checkOnBehalfOf records requests impersonating a subuser and rejects them
+// for parent only routes and unknown subusers. The fake does not keep
+// separate state per subuser.
+func (s *Server) checkOnBehalfOf(w http.ResponseWriter, r *http.Request, rt route) bool {
+	name := r.Header.Get("On-Behalf-Of")
+	if name == "" {
+		return true
+	}
+
+	s.onBehalfOf = append(s.onBehalfOf, name+" "+r.Method+" "+r.URL.Path)
+	if rt.parentOnly {
+		writeError(w, http.StatusForbidden, "", "access forbidden: on-behalf-of is not allowed for this endpoint")
+		return false
+	}
+	if _, ok := s.subusers[name]; !ok {
+		writeError(w, http.StatusForbidden, "", "access forbidden: unknown subuser "+name)
+		return false
+	}
+
+	return true
+}
+
+func match(pattern, segments []string) (map[string]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, p := range pattern {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			params[p[1:len(p)-1]] = segments[i]
+			continue
+		}
+		if p != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+func (s *Server) newID() int64 {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+type errorItem struct {
+	Field   *string `json:"field"`
+	Message string  `json:"message"`
+}
+
+func writeError(w http.ResponseWriter, status int, field, message string) {
+	item := errorItem{Message: message}
+	if field != "" {
+		item.Field = &field
+	}
+	writeJSON(w, status, map[string][]errorItem{"errors": {item}})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "", "resource not found")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decode reads the request body into v and reports a 400 when it is not
+// valid JSON.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "", fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+// page applies the limit and offset query parameters to a list of n items
+// and returns the bounds of the requested page. Like SendGrid it links the
+// next page in the Link header when there is one.
+func page(w http.ResponseWriter, r *http.Request, n int) (int, int) {
+	start, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	if start < 0 || start > n {
+		start = n
+	}
+
+	end := n
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err == nil && limit > 0 && start+limit < n {
+		end = start + limit
+
+		query := r.URL.Query()
+		query.Set("offset", strconv.Itoa(end))
+		next := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path, RawQuery: query.Encode()}
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"; title="%d"`, next.String(), end/limit+1))
+	}
+
+	return start, end
+}
+
+func parseID(w http.ResponseWriter, value string) (int64, bool) {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		writeNotFound(w)
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,143 @@
+package sendgridtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func do(t *testing.T, srv *Server, method, path, key, body string) (*http.Response, map[string]interface{}) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, srv.BaseURL()+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var decoded map[string]interface{}
+	_ = json.NewDecoder(resp.Body).Decode(&decoded)
+
+	return resp, decoded
+}
+
+func TestServerRouting(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		key    string
+		status int
+	}{
+		{"missing key", "GET", "/api_keys", "", http.StatusUnauthorized},
+		{"list", "GET", "/api_keys", APIKey, http.StatusOK},
+		{"unknown object", "GET", "/api_keys/missing", APIKey, http.StatusNotFound},
+		{"unknown path", "GET", "/nothing/here", APIKey, http.StatusNotFound},
+		{"wrong method", "PUT", "/verified_senders", APIKey, http.StatusMethodNotAllowed},
+		{"bad id", "GET", "/whitelabel/domains/abc", APIKey, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := do(t, srv, tt.method, tt.path, tt.key, "")
+			if resp.StatusCode != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status >= 400 && body["errors"] == nil {
+				t.Errorf("expected an errors array, got %v", body)
+			}
+		})
+	}
+}
+
+func TestServerFieldErrors(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	resp, body := do(t, srv, "POST", "/subusers", APIKey, `{"username":"sub1"}`)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", resp.StatusCode)
+	}
+
+	item := body["errors"].([]interface{})[0].(map[string]interface{})
+	if item["field"] != "email" {
+		t.Errorf("expected field error on email, got %v", item)
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	for _, name := range []string{"a", "b", "c"} {
+		if resp, _ := do(t, srv, "POST", "/api_keys", APIKey, `{"name":"`+name+`"}`); resp.StatusCode != http.StatusCreated {
+			t.Fatalf("create %s: status %d", name, resp.StatusCode)
+		}
+	}
+
+	_, body := do(t, srv, "GET", "/api_keys?limit=2&offset=1", APIKey, "")
+	result := body["result"].([]interface{})
+	if len(result) != 2 {
+		t.Fatalf("expected 2 keys, got %d", len(result))
+	}
+	if name := result[0].(map[string]interface{})["name"]; name != "b" {
+		t.Errorf("expected page to start at b, got %v", name)
+	}
+}
+
+func TestServerOnBehalfOf(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	if resp, _ := do(t, srv, "POST", "/subusers", APIKey, `{"username":"sub1","email":"sub1@example.com","password":"secret","ips":["192.0.2.1"]}`); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d", resp.StatusCode)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		subuser string
+		status  int
+	}{
+		{"subuser endpoint", "/api_keys", "sub1", http.StatusOK},
+		{"parent only endpoint", "/subusers", "sub1", http.StatusForbidden},
+		{"unknown subuser", "/api_keys", "missing", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest("GET", srv.BaseURL()+tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Authorization", "Bearer "+APIKey)
+			req.Header.Set("On-Behalf-Of", tt.subuser)
+
+			resp, err := srv.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, resp.StatusCode)
+			}
+		})
+	}
+
+	want := []string{"sub1 GET /v3/api_keys", "sub1 GET /v3/subusers", "missing GET /v3/api_keys"}
+	if got := srv.OnBehalfOf(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("expected recorded requests %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,166 @@
+package sendgridtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// settingValidator checks a setting after an update and returns the field
+// and the message of the first error.
+type settingValidator func(setting map[string]interface{}) (string, string)
+
+// registerSetting serves an account level setting at endpoint. Settings
+// always exist, start out as defaults and PATCH only changes the fields
+// present in the request.
+func (s *Server) registerSetting(endpoint string, defaults map[string]interface{}, validate settingValidator) {
+	s.settings[endpoint] = defaults
+
+	s.handle("GET", endpoint, func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		writeJSON(w, http.StatusOK, s.settings[endpoint])
+	})
+	s.handle("PATCH", endpoint, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		var body map[string]json.RawMessage
+		if !decode(w, r, &body) {
+			return
+		}
+
+		updated := map[string]interface{}{}
+		for key, value := range s.settings[endpoint] {
+			updated[key] = value
+		}
+		for key, raw := range body {
+			current, ok := updated[key]
+			if !ok {
+				writeError(w, http.StatusBadRequest, key, "unknown field")
+				return
+			}
+			if !decodeSettingField(raw, current, updated, key) {
+				writeError(w, http.StatusBadRequest, key, "invalid type")
+				return
+			}
+		}
+
+		if validate != nil {
+			if field, message := validate(updated); message != "" {
+				writeError(w, http.StatusBadRequest, field, message)
+				return
+			}
+		}
+		s.settings[endpoint] = updated
+
+		writeJSON(w, http.StatusOK, updated)
+	})
+}
+
+// decodeSettingField decodes raw into the type of the current value of key.
+func decodeSettingField(raw json.RawMessage, current interface{}, setting map[string]interface{}, key string) bool {
+	var err error
+	switch current.(type) {
+	case bool:
+		var v bool
+		err = json.Unmarshal(raw, &v)
+		setting[key] = v
+	case string:
+		var v string
+		err = json.Unmarshal(raw, &v)
+		setting[key] = v
+	case int64:
+		var v int64
+		err = json.Unmarshal(raw, &v)
+		setting[key] = v
+	case []string:
+		var v []string
+		err = json.Unmarshal(raw, &v)
+		if v == nil {
+			v = []string{}
+		}
+		setting[key] = v
+	}
+	return err == nil
+}
+
+func (s *Server) registerMailSettings() {
+	s.registerSetting("/mail_settings/bcc", map[string]interface{}{
+		"enabled": false,
+		"email":   "",
+	}, requireWhenEnabled("email"))
+	s.registerSetting("/mail_settings/footer", map[string]interface{}{
+		"enabled":       false,
+		"html_content":  "",
+		"plain_content": "",
+	}, nil)
+	s.registerSetting("/mail_settings/bounce_purge", map[string]interface{}{
+		"enabled":      false,
+		"soft_bounces": int64(0),
+		"hard_bounces": int64(0),
+	}, validateBouncePurge)
+	s.registerSetting("/mail_settings/forward_spam", map[string]interface{}{
+		"enabled": false,
+		"email":   "",
+	}, requireWhenEnabled("email"))
+	s.registerSetting("/mail_settings/forward_bounce", map[string]interface{}{
+		"enabled": false,
+		"email":   "",
+	}, requireWhenEnabled("email"))
+	s.registerSetting("/mail_settings/address_whitelist", map[string]interface{}{
+		"enabled": false,
+		"list":    []string{},
+	}, nil)
+}
+
+// requireWhenEnabled reports a missing field of an enabled setting.
+func requireWhenEnabled(field string) settingValidator {
+	return func(setting map[string]interface{}) (string, string) {
+		if setting["enabled"].(bool) && strings.TrimSpace(setting[field].(string)) == "" {
+			return field, field + " is required when the setting is enabled"
+		}
+		return "", ""
+	}
+}
+
+func validateBouncePurge(setting map[string]interface{}) (string, string) {
+	for _, field := range []string{"soft_bounces", "hard_bounces"} {
+		if days := setting[field].(int64); days < 0 || days > 3650 {
+			return field, field + " must be between 1 and 3650"
+		}
+	}
+	return "", ""
+}
+
+func (s *Server) registerTrackingSettings() {
+	s.registerSetting("/tracking_settings/click", map[string]interface{}{
+		"enabled":     false,
+		"enable_text": false,
+	}, nil)
+	s.registerSetting("/tracking_settings/open", map[string]interface{}{
+		"enabled": false,
+	}, nil)
+	s.registerSetting("/tracking_settings/subscription", map[string]interface{}{
+		"enabled":       false,
+		"html_content":  "",
+		"plain_content": "",
+		"landing":       "",
+		"replace":       "",
+		"url":           "",
+	}, validateSubscriptionTracking)
+	s.registerSetting("/tracking_settings/google_analytics", map[string]interface{}{
+		"enabled":      false,
+		"utm_source":   "",
+		"utm_medium":   "",
+		"utm_term":     "",
+		"utm_content":  "",
+		"utm_campaign": "",
+	}, nil)
+}
+
+// validateSubscriptionTracking requires the <% %> tag the unsubscribe link
+// is inserted at in custom contents.
+func validateSubscriptionTracking(setting map[string]interface{}) (string, string) {
+	for _, field := range []string{"html_content", "plain_content"} {
+		if content := setting[field].(string); content != "" && !strings.Contains(content, "<% %>") {
+			return field, field + " must contain the <% %> tag"
+		}
+	}
+	return "", ""
+}
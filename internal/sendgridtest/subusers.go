@@ -0,0 +1,260 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"sort"
+)
+
+type subuser struct {
+	ID       int64    `json:"id"`
+	Username string   `json:"username"`
+	Email    string   `json:"email"`
+	Ips      []string `json:"ips,omitempty"`
+	Disabled bool     `json:"disabled"`
+
+	credits subuserCredits
+}
+
+type subuserCredits struct {
+	Type           string `json:"type"`
+	ResetFrequency string `json:"reset_frequency,omitempty"`
+	Remain         int64  `json:"remain"`
+	Total          int64  `json:"total"`
+	Used           int64  `json:"used"`
+}
+
+func (s *Server) registerSubusers() {
+	s.handleParent("POST", "/subusers", s.createSubuser)
+	s.handleParent("GET", "/subusers", s.listSubusers)
+	s.handleParent("GET", "/subusers/{name}", s.getSubuser)
+	s.handleParent("PATCH", "/subusers/{name}", s.updateSubuser)
+	s.handleParent("DELETE", "/subusers/{name}", s.deleteSubuser)
+	s.handleParent("PUT", "/subusers/{name}/ips", s.setSubuserIPs)
+	s.handleParent("GET", "/subusers/{name}/credits", s.getSubuserCredits)
+	s.handleParent("PUT", "/subusers/{name}/credits", s.setSubuserCredits)
+	s.handleParent("PATCH", "/subusers/{name}/remaining_credits", s.adjustSubuserRemainingCredits)
+}
+
+func (s *Server) createSubuser(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body struct {
+		Username string   `json:"username"`
+		Email    string   `json:"email"`
+		Password string   `json:"password"`
+		Ips      []string `json:"ips"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	switch {
+	case body.Username == "":
+		writeError(w, http.StatusBadRequest, "username", "missing required argument")
+		return
+	case body.Email == "":
+		writeError(w, http.StatusBadRequest, "email", "missing required argument")
+		return
+	case body.Password == "":
+		writeError(w, http.StatusBadRequest, "password", "missing required argument")
+		return
+	}
+	if _, ok := s.subusers[body.Username]; ok {
+		writeError(w, http.StatusBadRequest, "username", "username exists")
+		return
+	}
+
+	user := &subuser{
+		ID:       s.newID(),
+		Username: body.Username,
+		Email:    body.Email,
+		Ips:      body.Ips,
+		credits:  subuserCredits{Type: "unlimited"},
+	}
+	s.subusers[user.Username] = user
+
+	writeJSON(w, http.StatusCreated, struct {
+		Username string `json:"username"`
+		UserID   int64  `json:"user_id"`
+		Email    string `json:"email"`
+	}{user.Username, user.ID, user.Email})
+}
+
+func (s *Server) listSubusers(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	users := make([]*subuser, 0, len(s.subusers))
+	for _, user := range s.subusers {
+		if name := r.URL.Query().Get("username"); name != "" && user.Username != name {
+			continue
+		}
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+
+	start, end := page(w, r, len(users))
+	writeJSON(w, http.StatusOK, users[start:end])
+}
+
+func (s *Server) getSubuser(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	user, ok := s.subusers[params["name"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+// updateSubuser only changes the fields present in the request body.
+func (s *Server) updateSubuser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user, ok := s.subusers[params["name"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	var body struct {
+		Disabled *bool    `json:"disabled"`
+		Ips      []string `json:"ips"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.Disabled != nil {
+		user.Disabled = *body.Disabled
+	}
+	if body.Ips != nil {
+		user.Ips = body.Ips
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteSubuser(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.subusers[params["name"]]; !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.subusers, params["name"])
+	writeNoContent(w)
+}
+
+// setSubuserIPs replaces the IPs of a subuser. Only IPs assigned to the
+// account can be given to subusers.
+func (s *Server) setSubuserIPs(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user, ok := s.subusers[params["name"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	var ips []string
+	if !decode(w, r, &ips) {
+		return
+	}
+	if len(ips) == 0 {
+		writeError(w, http.StatusBadRequest, "ips", "a subuser needs at least one ip")
+		return
+	}
+	for _, value := range ips {
+		if ip, ok := s.ips[value]; !ok || ip.AssignedAt == nil {
+			writeError(w, http.StatusBadRequest, "ips", "ip "+value+" is not assigned to the account")
+			return
+		}
+	}
+
+	user.Ips = ips
+
+	writeJSON(w, http.StatusOK, map[string][]string{"ips": ips})
+}
+
+func (s *Server) getSubuserCredits(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	user, ok := s.subusers[params["name"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, user.credits)
+}
+
+// setSubuserCredits replaces the credit limit of a subuser. The remaining
+// credits start over from the new total.
+func (s *Server) setSubuserCredits(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user, ok := s.subusers[params["name"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	var body struct {
+		Type           string `json:"type"`
+		ResetFrequency string `json:"reset_frequency"`
+		Total          *int64 `json:"total"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	switch body.Type {
+	case "unlimited":
+		if body.Total != nil || body.ResetFrequency != "" {
+			writeError(w, http.StatusBadRequest, "type", "unlimited credits have no total or reset frequency")
+			return
+		}
+	case "recurring", "nonrecurring":
+		if body.Total == nil || *body.Total < 0 {
+			writeError(w, http.StatusBadRequest, "total", "total must be a positive number")
+			return
+		}
+		if body.Type == "recurring" && body.ResetFrequency != "daily" && body.ResetFrequency != "weekly" && body.ResetFrequency != "monthly" {
+			writeError(w, http.StatusBadRequest, "reset_frequency", "reset_frequency must be daily, weekly or monthly")
+			return
+		}
+		if body.Type == "nonrecurring" && body.ResetFrequency != "" {
+			writeError(w, http.StatusBadRequest, "reset_frequency", "nonrecurring credits have no reset frequency")
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "type", "type must be unlimited, recurring or nonrecurring")
+		return
+	}
+
+	credits := subuserCredits{Type: body.Type, ResetFrequency: body.ResetFrequency, Used: user.credits.Used}
+	if body.Total != nil {
+		credits.Total = *body.Total
+		credits.Remain = credits.Total - credits.Used
+		if credits.Remain < 0 {
+			credits.Remain = 0
+		}
+	}
+	user.credits = credits
+
+	writeJSON(w, http.StatusOK, user.credits)
+}
+
+// adjustSubuserRemainingCredits adds allocation_update, which may be
+// negative, to the remaining credits of a subuser with a credit limit.
+func (s *Server) adjustSubuserRemainingCredits(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user, ok := s.subusers[params["name"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	var body struct {
+		AllocationUpdate int64 `json:"allocation_update"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	switch {
+	case user.credits.Type == "unlimited":
+		writeError(w, http.StatusBadRequest, "allocation_update", "the credits of the subuser are unlimited")
+		return
+	case user.credits.Remain+body.AllocationUpdate < 0:
+		writeError(w, http.StatusBadRequest, "allocation_update", "remaining credits cannot be negative")
+		return
+	}
+
+	user.credits.Remain += body.AllocationUpdate
+
+	writeJSON(w, http.StatusOK, user.credits)
+}
@@ -0,0 +1,184 @@
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+type teammate struct {
+	seq            int64
+	Username       string   `json:"username,omitempty"`
+	Email          string   `json:"email"`
+	FirstName      string   `json:"first_name,omitempty"`
+	LastName       string   `json:"last_name,omitempty"`
+	UserType       string   `json:"user_type,omitempty"`
+	IsAdmin        bool     `json:"is_admin"`
+	Scopes         []string `json:"scopes,omitempty"`
+	Token          string   `json:"token,omitempty"`
+	ExpirationDate int64    `json:"expiration_date,omitempty"`
+}
+
+func (s *Server) registerTeammates() {
+	s.handleParent("POST", "/teammates", s.inviteTeammate)
+	s.handleParent("GET", "/teammates", s.listTeammates)
+	s.handleParent("GET", "/teammates/pending", s.listPendingTeammates)
+	s.handleParent("DELETE", "/teammates/pending/{token}", s.deletePendingTeammate)
+	s.handleParent("GET", "/teammates/{username}", s.getTeammate)
+	s.handleParent("PATCH", "/teammates/{username}", s.updateTeammate)
+	s.handleParent("DELETE", "/teammates/{username}", s.deleteTeammate)
+}
+
+// AcceptInvite turns the pending invite for email into an active teammate,
+// as if the invitee had followed the link in the invitation mail. It returns
+// the username of the new teammate.
+func (s *Server) AcceptInvite(email string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token, invite := range s.pending {
+		if invite.Email != email {
+			continue
+		}
+
+		user := &teammate{
+			seq:      s.newID(),
+			Username: strings.SplitN(email, "@", 2)[0],
+			Email:    email,
+			IsAdmin:  invite.IsAdmin,
+			Scopes:   invite.Scopes,
+			UserType: "teammate",
+		}
+		if user.IsAdmin {
+			user.UserType = "admin"
+		}
+		s.teammates[user.Username] = user
+		delete(s.pending, token)
+
+		return user.Username, nil
+	}
+
+	return "", fmt.Errorf("sendgridtest: no pending invite for %s", email)
+}
+
+func (s *Server) inviteTeammate(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body teammate
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Email == "" {
+		writeError(w, http.StatusBadRequest, "email", "missing required argument")
+		return
+	}
+	if !body.IsAdmin && len(body.Scopes) == 0 {
+		writeError(w, http.StatusBadRequest, "scopes", "scopes are required for non admin teammates")
+		return
+	}
+	for _, user := range s.teammates {
+		if user.Email == body.Email {
+			writeError(w, http.StatusBadRequest, "email", "teammate already exists")
+			return
+		}
+	}
+	for _, invite := range s.pending {
+		if invite.Email == body.Email {
+			writeError(w, http.StatusBadRequest, "email", "teammate has already been invited")
+			return
+		}
+	}
+
+	seq := s.newID()
+	invite := &teammate{
+		seq:            seq,
+		Email:          body.Email,
+		IsAdmin:        body.IsAdmin,
+		Scopes:         body.Scopes,
+		Token:          fmt.Sprintf("fake-token-%d", seq),
+		ExpirationDate: time.Now().Add(7 * 24 * time.Hour).Unix(),
+	}
+	s.pending[invite.Token] = invite
+
+	writeJSON(w, http.StatusCreated, invite)
+}
+
+func (s *Server) listTeammates(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	users := sortedTeammates(s.teammates)
+
+	// the list endpoint does not include scopes
+	result := make([]teammate, 0, len(users))
+	for _, user := range users {
+		item := *user
+		item.Scopes = nil
+		result = append(result, item)
+	}
+
+	start, end := page(w, r, len(result))
+	writeJSON(w, http.StatusOK, map[string][]teammate{"result": result[start:end]})
+}
+
+func (s *Server) listPendingTeammates(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	invites := sortedTeammates(s.pending)
+
+	start, end := page(w, r, len(invites))
+	writeJSON(w, http.StatusOK, map[string][]*teammate{"result": invites[start:end]})
+}
+
+func (s *Server) deletePendingTeammate(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.pending[params["token"]]; !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.pending, params["token"])
+	writeNoContent(w)
+}
+
+func (s *Server) getTeammate(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	user, ok := s.teammates[params["username"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) updateTeammate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user, ok := s.teammates[params["username"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	var body teammate
+	if !decode(w, r, &body) {
+		return
+	}
+
+	user.IsAdmin = body.IsAdmin
+	user.Scopes = body.Scopes
+	user.UserType = "teammate"
+	if user.IsAdmin {
+		user.UserType = "admin"
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) deleteTeammate(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.teammates[params["username"]]; !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.teammates, params["username"])
+	writeNoContent(w)
+}
+
+func sortedTeammates(m map[string]*teammate) []*teammate {
+	users := make([]*teammate, 0, len(m))
+	for _, user := range m {
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].seq < users[j].seq })
+	return users
+}
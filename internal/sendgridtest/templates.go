@@ -0,0 +1,252 @@
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type template struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	Generation string             `json:"generation"`
+	UpdatedAt  string             `json:"updated_at"`
+	Versions   []*templateVersion `json:"versions"`
+}
+
+type templateVersion struct {
+	ID                   string `json:"id"`
+	TemplateID           string `json:"template_id"`
+	Name                 string `json:"name"`
+	Subject              string `json:"subject"`
+	HTMLContent          string `json:"html_content"`
+	PlainContent         string `json:"plain_content"`
+	GeneratePlainContent bool   `json:"generate_plain_content"`
+	Active               int    `json:"active"`
+	Editor               string `json:"editor"`
+	TestData             string `json:"test_data,omitempty"`
+	UpdatedAt            string `json:"updated_at"`
+}
+
+var htmlTags = regexp.MustCompile(`<[^>]*>`)
+
+func (s *Server) registerTemplates() {
+	s.handle("POST", "/templates", s.createTemplate)
+	s.handle("GET", "/templates/{id}", s.getTemplate)
+	s.handle("PATCH", "/templates/{id}", s.updateTemplate)
+	s.handle("DELETE", "/templates/{id}", s.deleteTemplate)
+	s.handle("POST", "/templates/{id}/versions", s.createTemplateVersion)
+	s.handle("GET", "/templates/{id}/versions/{version}", s.getTemplateVersion)
+	s.handle("PATCH", "/templates/{id}/versions/{version}", s.updateTemplateVersion)
+	s.handle("DELETE", "/templates/{id}/versions/{version}", s.deleteTemplateVersion)
+	s.handle("POST", "/templates/{id}/versions/{version}/activate", s.activateTemplateVersion)
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format("2006-01-02 15:04:05")
+}
+
+func (s *Server) createTemplate(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body template
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name", "missing required argument")
+		return
+	}
+
+	t := &template{
+		Name:       body.Name,
+		Generation: body.Generation,
+		UpdatedAt:  timestamp(),
+		Versions:   []*templateVersion{},
+	}
+	switch t.Generation {
+	case "":
+		t.Generation = "legacy"
+		fallthrough
+	case "legacy":
+		t.ID = fmt.Sprintf("%08x-0000-4000-8000-000000000000", s.newID())
+	case "dynamic":
+		t.ID = fmt.Sprintf("d-%032x", s.newID())
+	default:
+		writeError(w, http.StatusBadRequest, "generation", "generation must be either legacy or dynamic")
+		return
+	}
+	s.templates[t.ID] = t
+
+	writeJSON(w, http.StatusCreated, t)
+}
+
+func (s *Server) getTemplate(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	t, ok := s.templates[params["id"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) updateTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	t, ok := s.templates[params["id"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	var body template
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name", "missing required argument")
+		return
+	}
+
+	t.Name = body.Name
+	t.UpdatedAt = timestamp()
+
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteTemplate(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.templates[params["id"]]; !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.templates, params["id"])
+	writeNoContent(w)
+}
+
+func (s *Server) createTemplateVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	t, ok := s.templates[params["id"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	body := templateVersion{GeneratePlainContent: true}
+	if !decode(w, r, &body) {
+		return
+	}
+	switch {
+	case body.Name == "":
+		writeError(w, http.StatusBadRequest, "name", "missing required argument")
+		return
+	case body.Subject == "":
+		writeError(w, http.StatusBadRequest, "subject", "missing required argument")
+		return
+	}
+
+	v := &body
+	v.ID = fmt.Sprintf("%08x-0000-4000-8000-%012x", s.newID(), len(t.Versions))
+	v.TemplateID = t.ID
+	if v.Editor == "" {
+		v.Editor = "code"
+	}
+	refreshTemplateVersion(v)
+	t.Versions = append(t.Versions, v)
+
+	// the first version of a template is always active
+	if v.Active == 1 || len(t.Versions) == 1 {
+		activate(t, v)
+	}
+	t.UpdatedAt = v.UpdatedAt
+
+	writeJSON(w, http.StatusCreated, v)
+}
+
+func (s *Server) getTemplateVersion(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	_, v, ok := s.lookupTemplateVersion(w, params)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, v)
+}
+
+// updateTemplateVersion only changes the fields present in the request body.
+func (s *Server) updateTemplateVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	t, v, ok := s.lookupTemplateVersion(w, params)
+	if !ok {
+		return
+	}
+
+	updated := *v
+	updated.Active = 0
+	if !decode(w, r, &updated) {
+		return
+	}
+	updated.ID = v.ID
+	updated.TemplateID = v.TemplateID
+
+	activeRequested := updated.Active == 1
+	updated.Active = v.Active
+	*v = updated
+	refreshTemplateVersion(v)
+	if activeRequested {
+		activate(t, v)
+	}
+
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (s *Server) deleteTemplateVersion(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	t, v, ok := s.lookupTemplateVersion(w, params)
+	if !ok {
+		return
+	}
+
+	for i, existing := range t.Versions {
+		if existing == v {
+			t.Versions = append(t.Versions[:i], t.Versions[i+1:]...)
+			break
+		}
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) activateTemplateVersion(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	t, v, ok := s.lookupTemplateVersion(w, params)
+	if !ok {
+		return
+	}
+
+	activate(t, v)
+
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (s *Server) lookupTemplateVersion(w http.ResponseWriter, params map[string]string) (*template, *templateVersion, bool) {
+	t, ok := s.templates[params["id"]]
+	if !ok {
+		writeNotFound(w)
+		return nil, nil, false
+	}
+
+	for _, v := range t.Versions {
+		if v.ID == params["version"] {
+			return t, v, true
+		}
+	}
+
+	writeNotFound(w)
+	return nil, nil, false
+}
+
+func activate(t *template, active *templateVersion) {
+	for _, v := range t.Versions {
+		v.Active = 0
+	}
+	active.Active = 1
+}
+
+func refreshTemplateVersion(v *templateVersion) {
+	if v.GeneratePlainContent {
+		v.PlainContent = strings.TrimSpace(htmlTags.ReplaceAllString(v.HTMLContent, ""))
+	}
+	v.UpdatedAt = timestamp()
+}
@@ -0,0 +1,193 @@
+package sendgridtest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type eventWebhook struct {
+	Enabled           bool   `json:"enabled"`
+	URL               string `json:"url"`
+	GroupResubscribe  bool   `json:"group_resubscribe"`
+	Delivered         bool   `json:"delivered"`
+	GroupUnsubscribe  bool   `json:"group_unsubscribe"`
+	SpamReport        bool   `json:"spam_report"`
+	Bounce            bool   `json:"bounce"`
+	Deferred          bool   `json:"deferred"`
+	Unsubscribe       bool   `json:"unsubscribe"`
+	Processed         bool   `json:"processed"`
+	Open              bool   `json:"open"`
+	Click             bool   `json:"click"`
+	Dropped           bool   `json:"dropped"`
+	OAuthClientID     string `json:"oauth_client_id"`
+	OAuthClientSecret string `json:"oauth_client_secret,omitempty"`
+	OAuthTokenURL     string `json:"oauth_token_url"`
+}
+
+type inboundParse struct {
+	Hostname  string `json:"hostname"`
+	URL       string `json:"url"`
+	SpamCheck bool   `json:"spam_check"`
+	SendRaw   bool   `json:"send_raw"`
+}
+
+func (s *Server) registerWebhooks() {
+	s.handle("GET", "/user/webhooks/event/settings", s.getEventWebhook)
+	s.handle("PATCH", "/user/webhooks/event/settings", s.updateEventWebhook)
+	s.handle("GET", "/user/webhooks/event/settings/signed", s.getEventWebhookSigning)
+	s.handle("PATCH", "/user/webhooks/event/settings/signed", s.updateEventWebhookSigning)
+	s.handle("POST", "/user/webhooks/parse/settings", s.createInboundParse)
+	s.handle("GET", "/user/webhooks/parse/settings", s.listInboundParses)
+	s.handle("GET", "/user/webhooks/parse/settings/{hostname}", s.getInboundParse)
+	s.handle("PATCH", "/user/webhooks/parse/settings/{hostname}", s.updateInboundParse)
+	s.handle("DELETE", "/user/webhooks/parse/settings/{hostname}", s.deleteInboundParse)
+}
+
+// eventWebhookResponse never includes the OAuth client secret.
+func (s *Server) eventWebhookResponse() eventWebhook {
+	webhook := s.eventWebhook
+	webhook.OAuthClientSecret = ""
+	return webhook
+}
+
+func (s *Server) getEventWebhook(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, s.eventWebhookResponse())
+}
+
+func (s *Server) updateEventWebhook(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	body := s.eventWebhook
+	if !decode(w, r, &body) {
+		return
+	}
+
+	switch {
+	case body.Enabled && body.URL == "":
+		writeError(w, http.StatusBadRequest, "url", "url is required when the webhook is enabled")
+		return
+	case body.URL != "" && !strings.HasPrefix(body.URL, "https://") && !strings.HasPrefix(body.URL, "http://"):
+		writeError(w, http.StatusBadRequest, "url", "url must be a valid URL")
+		return
+	case body.OAuthClientID != "" && body.OAuthTokenURL == "":
+		writeError(w, http.StatusBadRequest, "oauth_token_url", "oauth_token_url is required with oauth_client_id")
+		return
+	}
+
+	s.eventWebhook = body
+
+	writeJSON(w, http.StatusOK, s.eventWebhookResponse())
+}
+
+func (s *Server) getEventWebhookSigning(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, map[string]string{"public_key": s.eventWebhookKey})
+}
+
+// updateEventWebhookSigning generates a new public key every time signing
+// is enabled.
+func (s *Server) updateEventWebhookSigning(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body struct {
+		Enabled bool `json:"enabled"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.eventWebhookKey = ""
+	if body.Enabled {
+		s.eventWebhookKey = base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("sendgridtest-public-key-%d", s.newID())))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"public_key": s.eventWebhookKey})
+}
+
+func (s *Server) createInboundParse(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body inboundParse
+	if !decode(w, r, &body) {
+		return
+	}
+
+	switch {
+	case body.Hostname == "":
+		writeError(w, http.StatusBadRequest, "hostname", "missing required argument")
+		return
+	case body.URL == "":
+		writeError(w, http.StatusBadRequest, "url", "missing required argument")
+		return
+	case !s.authenticatedHostname(body.Hostname):
+		writeError(w, http.StatusBadRequest, "hostname", "hostname must belong to an authenticated domain")
+		return
+	}
+	if _, ok := s.parses[body.Hostname]; ok {
+		writeError(w, http.StatusBadRequest, "hostname", "a setting already exists for this hostname")
+		return
+	}
+
+	p := body
+	s.parses[p.Hostname] = &p
+
+	writeJSON(w, http.StatusCreated, p)
+}
+
+func (s *Server) listInboundParses(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	parses := make([]*inboundParse, 0, len(s.parses))
+	for _, p := range s.parses {
+		parses = append(parses, p)
+	}
+	sort.Slice(parses, func(i, j int) bool { return parses[i].Hostname < parses[j].Hostname })
+
+	writeJSON(w, http.StatusOK, map[string][]*inboundParse{"result": parses})
+}
+
+func (s *Server) getInboundParse(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.parses[params["hostname"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) updateInboundParse(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.parses[params["hostname"]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	body := *p
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.URL == "" {
+		writeError(w, http.StatusBadRequest, "url", "missing required argument")
+		return
+	}
+
+	p.URL = body.URL
+	p.SpamCheck = body.SpamCheck
+	p.SendRaw = body.SendRaw
+
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) deleteInboundParse(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.parses[params["hostname"]]; !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.parses, params["hostname"])
+	writeNoContent(w)
+}
+
+// authenticatedHostname reports whether hostname is an authenticated domain
+// or one of its subdomains.
+func (s *Server) authenticatedHostname(hostname string) bool {
+	for _, d := range s.domains {
+		if hostname == d.Domain || strings.HasSuffix(hostname, "."+d.Domain) {
+			return true
+		}
+	}
+	return false
+}
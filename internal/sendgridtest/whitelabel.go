@@ -0,0 +1,505 @@
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	accountUserID   = 1000
+	accountUsername = "sendgridtest"
+)
+
+type dnsRecord struct {
+	Host  string `json:"host"`
+	Type  string `json:"type"`
+	Data  string `json:"data"`
+	Valid bool   `json:"valid"`
+}
+
+type domainSubuser struct {
+	Username string `json:"username"`
+	UserID   int64  `json:"user_id"`
+}
+
+type domain struct {
+	ID         int64                 `json:"id"`
+	UserID     int64                 `json:"user_id"`
+	Domain     string                `json:"domain"`
+	Subdomain  string                `json:"subdomain"`
+	CustomDKIM string                `json:"custom_dkim_selector,omitempty"`
+	Username   string                `json:"username"`
+	Ips        []string              `json:"ips"`
+	CustomSPF  bool                  `json:"custom_spf"`
+	Default    bool                  `json:"default"`
+	Legacy     bool                  `json:"legacy"`
+	Valid      bool                  `json:"valid"`
+	DNS        map[string]*dnsRecord `json:"dns"`
+	Subusers   []domainSubuser       `json:"subusers"`
+}
+
+type link struct {
+	ID        int64                 `json:"id"`
+	UserID    int64                 `json:"user_id"`
+	Domain    string                `json:"domain"`
+	Subdomain string                `json:"subdomain"`
+	Username  string                `json:"username"`
+	Default   bool                  `json:"default"`
+	Legacy    bool                  `json:"legacy"`
+	Valid     bool                  `json:"valid"`
+	DNS       map[string]*dnsRecord `json:"dns"`
+}
+
+type reverseDNSUser struct {
+	Username string `json:"username"`
+	UserID   int64  `json:"user_id"`
+}
+
+type reverseDNS struct {
+	ID        int64            `json:"id"`
+	IP        string           `json:"ip"`
+	Rdns      string           `json:"rdns"`
+	Users     []reverseDNSUser `json:"users"`
+	Subdomain string           `json:"subdomain"`
+	Domain    string           `json:"domain"`
+	Valid     bool             `json:"valid"`
+	Legacy    bool             `json:"legacy"`
+	ARecord   *dnsRecord       `json:"a_record"`
+}
+
+func (s *Server) registerDomains() {
+	s.handle("POST", "/whitelabel/domains", s.createDomain)
+	s.handle("GET", "/whitelabel/domains", s.listDomains)
+	s.handleParent("DELETE", "/whitelabel/domains/subuser", s.disassociateDomainSubuser)
+	s.handle("GET", "/whitelabel/domains/{id}", s.getDomain)
+	s.handle("PATCH", "/whitelabel/domains/{id}", s.updateDomain)
+	s.handle("DELETE", "/whitelabel/domains/{id}", s.deleteDomain)
+	s.handle("POST", "/whitelabel/domains/{id}/validate", s.validateDomain)
+	s.handleParent("POST", "/whitelabel/domains/{id}/subuser", s.associateDomainSubuser)
+}
+
+func (s *Server) registerLinks() {
+	s.handle("POST", "/whitelabel/links", s.createLink)
+	s.handle("GET", "/whitelabel/links", s.listLinks)
+	s.handle("GET", "/whitelabel/links/{id}", s.getLink)
+	s.handle("PATCH", "/whitelabel/links/{id}", s.updateLink)
+	s.handle("DELETE", "/whitelabel/links/{id}", s.deleteLink)
+	s.handle("POST", "/whitelabel/links/{id}/validate", s.validateLink)
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body domain
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Domain == "" {
+		writeError(w, http.StatusBadRequest, "domain", "missing required argument")
+		return
+	}
+
+	d := &domain{
+		ID:         s.newID(),
+		UserID:     accountUserID,
+		Domain:     body.Domain,
+		Subdomain:  body.Subdomain,
+		CustomDKIM: body.CustomDKIM,
+		Username:   accountUsername,
+		Ips:        body.Ips,
+		CustomSPF:  body.CustomSPF,
+		Default:    body.Default,
+		Legacy:     body.Legacy,
+		Subusers:   []domainSubuser{},
+	}
+	if d.Ips == nil {
+		d.Ips = []string{}
+	}
+	if d.Subdomain == "" {
+		d.Subdomain = fmt.Sprintf("em%d", d.ID)
+	}
+	d.DNS = map[string]*dnsRecord{
+		"mail_cname": {Host: d.Subdomain + "." + d.Domain, Type: "cname", Data: fmt.Sprintf("u%d.wl.sendgrid.net", accountUserID)},
+		"dkim1":      {Host: "s1._domainkey." + d.Domain, Type: "cname", Data: fmt.Sprintf("s1.domainkey.u%d.wl.sendgrid.net", accountUserID)},
+		"dkim2":      {Host: "s2._domainkey." + d.Domain, Type: "cname", Data: fmt.Sprintf("s2.domainkey.u%d.wl.sendgrid.net", accountUserID)},
+	}
+	s.domains[d.ID] = d
+
+	writeJSON(w, http.StatusCreated, d)
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	query := r.URL.Query()
+
+	domains := make([]*domain, 0, len(s.domains))
+	for _, d := range s.domains {
+		if name := query.Get("domain"); name != "" && d.Domain != name {
+			continue
+		}
+		if username := query.Get("username"); username != "" && d.Username != username {
+			continue
+		}
+		domains = append(domains, d)
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].ID < domains[j].ID })
+
+	start, end := page(w, r, len(domains))
+	writeJSON(w, http.StatusOK, domains[start:end])
+}
+
+func (s *Server) getDomain(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	d, ok := s.lookupDomain(w, params["id"])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) updateDomain(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	d, ok := s.lookupDomain(w, params["id"])
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Default   *bool `json:"default"`
+		CustomSPF *bool `json:"custom_spf"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.Default != nil {
+		d.Default = *body.Default
+	}
+	if body.CustomSPF != nil {
+		d.CustomSPF = *body.CustomSPF
+	}
+
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	d, ok := s.lookupDomain(w, params["id"])
+	if !ok {
+		return
+	}
+	delete(s.domains, d.ID)
+	writeNoContent(w)
+}
+
+// validateDomain always succeeds, the fake has no DNS to check.
+func (s *Server) validateDomain(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	d, ok := s.lookupDomain(w, params["id"])
+	if !ok {
+		return
+	}
+
+	d.Valid = true
+	results := map[string]interface{}{}
+	for name, record := range d.DNS {
+		record.Valid = true
+		results[name] = map[string]interface{}{"valid": true, "reason": nil}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":                 d.ID,
+		"valid":              d.Valid,
+		"validation_results": results,
+	})
+}
+
+func (s *Server) associateDomainSubuser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	d, ok := s.lookupDomain(w, params["id"])
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Username string `json:"username"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+	user, ok := s.subusers[body.Username]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "username", "subuser does not exist")
+		return
+	}
+
+	d.Subusers = []domainSubuser{{Username: user.Username, UserID: user.ID}}
+
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) disassociateDomainSubuser(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	username := r.URL.Query().Get("username")
+
+	found := false
+	for _, d := range s.domains {
+		for _, user := range d.Subusers {
+			if user.Username == username {
+				d.Subusers = []domainSubuser{}
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		writeNotFound(w)
+		return
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) lookupDomain(w http.ResponseWriter, value string) (*domain, bool) {
+	id, ok := parseID(w, value)
+	if !ok {
+		return nil, false
+	}
+	d, ok := s.domains[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return d, true
+}
+
+func (s *Server) createLink(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body link
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Domain == "" {
+		writeError(w, http.StatusBadRequest, "domain", "missing required argument")
+		return
+	}
+
+	l := &link{
+		ID:        s.newID(),
+		UserID:    accountUserID,
+		Domain:    body.Domain,
+		Subdomain: body.Subdomain,
+		Username:  accountUsername,
+		Default:   body.Default,
+	}
+	if l.Subdomain == "" {
+		l.Subdomain = "url" + fmt.Sprint(l.ID)
+	}
+	l.DNS = map[string]*dnsRecord{
+		"domain_cname": {Host: l.Subdomain + "." + l.Domain, Type: "cname", Data: "sendgrid.net"},
+		"owner_cname":  {Host: fmt.Sprintf("%d.%s", accountUserID, l.Domain), Type: "cname", Data: "sendgrid.net"},
+	}
+	s.links[l.ID] = l
+
+	writeJSON(w, http.StatusCreated, l)
+}
+
+func (s *Server) listLinks(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	links := make([]*link, 0, len(s.links))
+	for _, l := range s.links {
+		links = append(links, l)
+	}
+	sort.Slice(links, func(i, j int) bool { return links[i].ID < links[j].ID })
+
+	start, end := page(w, r, len(links))
+	writeJSON(w, http.StatusOK, links[start:end])
+}
+
+func (s *Server) getLink(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	l, ok := s.lookupLink(w, params["id"])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, l)
+}
+
+func (s *Server) updateLink(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	l, ok := s.lookupLink(w, params["id"])
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Default *bool `json:"default"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Default != nil {
+		l.Default = *body.Default
+	}
+
+	writeJSON(w, http.StatusOK, l)
+}
+
+func (s *Server) deleteLink(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	l, ok := s.lookupLink(w, params["id"])
+	if !ok {
+		return
+	}
+	delete(s.links, l.ID)
+	writeNoContent(w)
+}
+
+// validateLink always succeeds, the fake has no DNS to check.
+func (s *Server) validateLink(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	l, ok := s.lookupLink(w, params["id"])
+	if !ok {
+		return
+	}
+
+	l.Valid = true
+	results := map[string]interface{}{}
+	for name, record := range l.DNS {
+		record.Valid = true
+		results[name] = map[string]interface{}{"valid": true, "reason": nil}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":                 l.ID,
+		"valid":              l.Valid,
+		"validation_results": results,
+	})
+}
+
+func (s *Server) lookupLink(w http.ResponseWriter, value string) (*link, bool) {
+	id, ok := parseID(w, value)
+	if !ok {
+		return nil, false
+	}
+	l, ok := s.links[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return l, true
+}
+
+func (s *Server) registerReverseDNS() {
+	s.handle("POST", "/whitelabel/ips", s.createReverseDNS)
+	s.handle("GET", "/whitelabel/ips", s.listReverseDNS)
+	s.handle("GET", "/whitelabel/ips/{id}", s.getReverseDNS)
+	s.handle("DELETE", "/whitelabel/ips/{id}", s.deleteReverseDNS)
+	s.handle("POST", "/whitelabel/ips/{id}/validate", s.validateReverseDNS)
+}
+
+// createReverseDNS sets up reverse DNS for an IP of the account. An IP has
+// at most one reverse DNS record.
+func (s *Server) createReverseDNS(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body reverseDNS
+	if !decode(w, r, &body) {
+		return
+	}
+
+	ip, ok := s.ips[body.IP]
+	switch {
+	case body.IP == "":
+		writeError(w, http.StatusBadRequest, "ip", "missing required argument")
+		return
+	case body.Domain == "":
+		writeError(w, http.StatusBadRequest, "domain", "missing required argument")
+		return
+	case !ok:
+		writeError(w, http.StatusBadRequest, "ip", "ip address not found on the account")
+		return
+	case ip.Whitelabeled:
+		writeError(w, http.StatusBadRequest, "ip", "ip address already has reverse dns")
+		return
+	}
+
+	rdns := &reverseDNS{
+		ID:        s.newID(),
+		IP:        body.IP,
+		Users:     []reverseDNSUser{{Username: accountUsername, UserID: accountUserID}},
+		Subdomain: body.Subdomain,
+		Domain:    body.Domain,
+	}
+	if rdns.Subdomain == "" {
+		rdns.Subdomain = "o" + fmt.Sprint(rdns.ID)
+	}
+	rdns.Rdns = rdns.Subdomain + "." + rdns.Domain
+	rdns.ARecord = &dnsRecord{Host: rdns.Rdns, Type: "a", Data: rdns.IP}
+	s.reverseDNS[rdns.ID] = rdns
+
+	ip.Rdns = rdns.Rdns
+	ip.Whitelabeled = true
+
+	writeJSON(w, http.StatusCreated, rdns)
+}
+
+func (s *Server) listReverseDNS(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	records := make([]*reverseDNS, 0, len(s.reverseDNS))
+	for _, rdns := range s.reverseDNS {
+		if ip := r.URL.Query().Get("ip"); ip != "" && rdns.IP != ip {
+			continue
+		}
+		records = append(records, rdns)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].ID < records[j].ID })
+
+	start, end := page(w, r, len(records))
+	writeJSON(w, http.StatusOK, records[start:end])
+}
+
+func (s *Server) getReverseDNS(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	rdns, ok := s.lookupReverseDNS(w, params["id"])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, rdns)
+}
+
+func (s *Server) deleteReverseDNS(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	rdns, ok := s.lookupReverseDNS(w, params["id"])
+	if !ok {
+		return
+	}
+
+	delete(s.reverseDNS, rdns.ID)
+	if ip, ok := s.ips[rdns.IP]; ok {
+		ip.Rdns = ""
+		ip.Whitelabeled = false
+	}
+
+	writeNoContent(w)
+}
+
+// validateReverseDNS succeeds unless the domain is under the reserved
+// .invalid top level domain, the fake has no DNS to check.
+func (s *Server) validateReverseDNS(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	rdns, ok := s.lookupReverseDNS(w, params["id"])
+	if !ok {
+		return
+	}
+
+	aRecord := map[string]interface{}{"valid": true, "reason": nil}
+	if strings.HasSuffix(rdns.Domain, ".invalid") {
+		aRecord = map[string]interface{}{"valid": false, "reason": "Expected a record to match " + rdns.IP + " but found no record."}
+	} else {
+		rdns.Valid = true
+		rdns.ARecord.Valid = true
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":    rdns.ID,
+		"valid": rdns.Valid,
+		"validation_results": map[string]interface{}{
+			"a_record": aRecord,
+		},
+	})
+}
+
+func (s *Server) lookupReverseDNS(w http.ResponseWriter, value string) (*reverseDNS, bool) {
+	id, ok := parseID(w, value)
+	if !ok {
+		return nil, false
+	}
+	rdns, ok := s.reverseDNS[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return rdns, true
+}
@@ -0,0 +1,91 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"sort"
+	"time"
+)
+
+type whitelistedIP struct {
+	ID        int64  `json:"id"`
+	IP        string `json:"ip"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+func (s *Server) registerWhitelist() {
+	s.handle("POST", "/access_settings/whitelist", s.addWhitelistedIPs)
+	s.handle("GET", "/access_settings/whitelist", s.listWhitelistedIPs)
+	s.handle("GET", "/access_settings/whitelist/{id}", s.getWhitelistedIP)
+	s.handle("DELETE", "/access_settings/whitelist/{id}", s.deleteWhitelistedIP)
+}
+
+func (s *Server) addWhitelistedIPs(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var body struct {
+		Ips []struct {
+			IP string `json:"ip"`
+		} `json:"ips"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+	if len(body.Ips) == 0 {
+		writeError(w, http.StatusBadRequest, "ips", "missing required argument")
+		return
+	}
+
+	now := time.Now().Unix()
+	added := make([]*whitelistedIP, 0, len(body.Ips))
+	for _, item := range body.Ips {
+		if item.IP == "" {
+			writeError(w, http.StatusBadRequest, "ips", "ip is required")
+			return
+		}
+		ip := &whitelistedIP{ID: s.newID(), IP: item.IP, CreatedAt: now, UpdatedAt: now}
+		s.whitelist[ip.ID] = ip
+		added = append(added, ip)
+	}
+
+	writeJSON(w, http.StatusCreated, map[string][]*whitelistedIP{"result": added})
+}
+
+func (s *Server) listWhitelistedIPs(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ips := make([]*whitelistedIP, 0, len(s.whitelist))
+	for _, ip := range s.whitelist {
+		ips = append(ips, ip)
+	}
+	sort.Slice(ips, func(i, j int) bool { return ips[i].ID < ips[j].ID })
+
+	start, end := page(w, r, len(ips))
+	writeJSON(w, http.StatusOK, map[string][]*whitelistedIP{"result": ips[start:end]})
+}
+
+func (s *Server) getWhitelistedIP(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	ip, ok := s.lookupWhitelistedIP(w, params["id"])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]*whitelistedIP{"result": ip})
+}
+
+func (s *Server) deleteWhitelistedIP(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	ip, ok := s.lookupWhitelistedIP(w, params["id"])
+	if !ok {
+		return
+	}
+	delete(s.whitelist, ip.ID)
+	writeNoContent(w)
+}
+
+func (s *Server) lookupWhitelistedIP(w http.ResponseWriter, value string) (*whitelistedIP, bool) {
+	id, ok := parseID(w, value)
+	if !ok {
+		return nil, false
+	}
+	ip, ok := s.whitelist[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return ip, true
+}